@@ -0,0 +1,3 @@
+data "defectdojo_development_environment" "production" {
+  name = "Production"
+}
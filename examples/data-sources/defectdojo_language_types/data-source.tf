@@ -0,0 +1 @@
+data "defectdojo_language_types" "all" {}
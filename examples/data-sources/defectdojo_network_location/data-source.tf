@@ -0,0 +1,3 @@
+data "defectdojo_network_location" "dmz" {
+  location = "DMZ"
+}
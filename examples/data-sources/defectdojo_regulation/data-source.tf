@@ -0,0 +1,10 @@
+data "defectdojo_regulation" "gdpr" {
+  acronym = "GDPR"
+}
+
+resource "defectdojo_product" "web_shop" {
+  name        = "Web Shop"
+  description = "The public web shop"
+  prod_type   = 1
+  regulations = [data.defectdojo_regulation.gdpr.id]
+}
@@ -0,0 +1,3 @@
+data "defectdojo_sla_configuration" "default" {
+  name = "Default"
+}
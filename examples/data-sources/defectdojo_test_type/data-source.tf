@@ -0,0 +1,3 @@
+data "defectdojo_test_type" "zap" {
+  name = "ZAP Scan"
+}
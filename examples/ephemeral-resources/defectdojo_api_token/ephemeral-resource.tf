@@ -0,0 +1,11 @@
+ephemeral "defectdojo_api_token" "scanner" {
+  username = "scanner"
+  password = var.scanner_password
+}
+
+resource "vault_kv_secret_v2" "scanner" {
+  mount                = "secret"
+  name                 = "defectdojo/scanner"
+  data_json_wo         = jsonencode({ token = ephemeral.defectdojo_api_token.scanner.token })
+  data_json_wo_version = 1
+}
@@ -2,5 +2,10 @@ provider "defectdojo" {
   host     = "https://defectdojo.example.com"
   username = "admin"
   password = "password"
-  token    = "token"
+}
+
+# or authenticate with an existing token instead
+provider "defectdojo" {
+  host  = "https://defectdojo.example.com"
+  token = "token"
 }
@@ -0,0 +1,12 @@
+variable "maintenance" {
+  type    = bool
+  default = false
+}
+
+resource "defectdojo_announcement" "maintenance" {
+  count = var.maintenance ? 1 : 0
+
+  message     = "Maintenance in progress, imports are paused"
+  style       = "warning"
+  dismissable = false
+}
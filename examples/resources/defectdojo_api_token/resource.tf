@@ -0,0 +1,9 @@
+resource "defectdojo_user" "scanner" {
+  username = "scanner"
+  email    = "scanner@provider.com"
+}
+
+resource "defectdojo_api_token" "scanner" {
+  user             = defectdojo_user.scanner.id
+  rotation_version = 1
+}
@@ -0,0 +1,18 @@
+variable "scanner_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "defectdojo_credential" "scanner" {
+  name             = "Staging Scanner"
+  username         = "scanner"
+  password         = var.scanner_password
+  password_version = 1
+  role             = "admin"
+  authentication   = "Form"
+  url              = "https://staging.example.com/login"
+  environment      = defectdojo_development_environment.staging.id
+  login_regex      = "Welcome back"
+  logout_regex     = "You have been signed out"
+}
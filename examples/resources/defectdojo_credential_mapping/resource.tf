@@ -0,0 +1,5 @@
+resource "defectdojo_credential_mapping" "scanner" {
+  credential      = defectdojo_credential.scanner.id
+  product         = defectdojo_product.app.id
+  url_credentials = true
+}
@@ -0,0 +1,3 @@
+resource "defectdojo_development_environment" "pre_production" {
+  name = "Pre-Production"
+}
@@ -0,0 +1,26 @@
+resource "defectdojo_product_type" "test_product_type" {
+  name        = "Test Product Type"
+  description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+  name        = "Test Product"
+  description = "This is the description of the Test Product"
+  prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+# configure the endpoint with its url
+resource "defectdojo_endpoint" "test_endpoint" {
+  product = defectdojo_product.test_product.id
+  url     = "https://a.example.com/login"
+  tags    = ["public"]
+}
+
+# or with its components
+resource "defectdojo_endpoint" "test_endpoint_components" {
+  product  = defectdojo_product.test_product.id
+  protocol = "https"
+  host     = "api.example.com"
+  port     = 8443
+  path     = "v1"
+}
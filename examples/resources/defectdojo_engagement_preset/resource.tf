@@ -0,0 +1,19 @@
+data "defectdojo_test_type" "pentest" {
+  name = "Pen Test"
+}
+
+resource "defectdojo_engagement_preset" "pentest" {
+  title     = "Annual Pentest"
+  notes     = "Coordinate the test window with the operations team"
+  scope     = "All public endpoints of the web shop"
+  product   = defectdojo_product.web_shop.id
+  test_type = [data.defectdojo_test_type.pentest.id]
+}
+
+resource "defectdojo_engagement" "pentest_2025" {
+  name         = "Pentest 2025"
+  product      = defectdojo_product.web_shop.id
+  target_start = "2025-03-01"
+  target_end   = "2025-03-14"
+  preset       = defectdojo_engagement_preset.pentest.id
+}
@@ -0,0 +1,13 @@
+resource "defectdojo_finding_template" "reflected_xss" {
+  title                = "Reflected Cross-Site Scripting"
+  cwe                  = 79
+  cvssv3               = "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N"
+  severity             = "Medium"
+  description          = "User input is reflected in the response without encoding."
+  mitigation           = "Encode all user input according to the output context."
+  impact               = "An attacker can execute scripts in the browser of a victim, e.g. to steal the session."
+  references           = "https://owasp.org/www-community/attacks/xss/"
+  template_match       = true
+  template_match_title = false
+  tags                 = ["web", "pentest"]
+}
@@ -0,0 +1,23 @@
+variable "jira_api_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "defectdojo_jira_instance" "jira" {
+  configuration_name        = "Company JIRA"
+  url                       = "https://jira.example.com"
+  username                  = "defectdojo@example.com"
+  password                  = var.jira_api_token
+  password_version          = 1
+  default_issue_type        = "Bug"
+  epic_name_id              = 10011
+  open_status_key           = 11
+  close_status_key          = 31
+  info_mapping_severity     = "Trivial"
+  low_mapping_severity      = "Minor"
+  medium_mapping_severity   = "Major"
+  high_mapping_severity     = "Critical"
+  critical_mapping_severity = "Blocker"
+  finding_text              = "Reported by Defectdojo"
+}
@@ -0,0 +1,39 @@
+variable "jira_api_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "defectdojo_product_type" "test_product_type" {
+  name = "Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+  name        = "Test Product"
+  description = "This is the description of the Test Product"
+  prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_jira_instance" "jira" {
+  url                       = "https://jira.example.com"
+  username                  = "defectdojo@example.com"
+  password                  = var.jira_api_token
+  epic_name_id              = 10011
+  open_status_key           = 11
+  close_status_key          = 31
+  info_mapping_severity     = "Trivial"
+  low_mapping_severity      = "Minor"
+  medium_mapping_severity   = "Major"
+  high_mapping_severity     = "Critical"
+  critical_mapping_severity = "Blocker"
+}
+
+resource "defectdojo_jira_product_configuration" "test_product" {
+  product                        = defectdojo_product.test_product.id
+  jira_instance                  = defectdojo_jira_instance.jira.id
+  project_key                    = "SEC"
+  component                      = "Findings"
+  push_all_issues                = true
+  enable_engagement_epic_mapping = true
+  push_notes                     = true
+}
@@ -0,0 +1,6 @@
+resource "defectdojo_metadata" "on_call" {
+  target_type = "endpoint"
+  target_id   = defectdojo_endpoint.api.id
+  name        = "on_call"
+  value       = "platform-team"
+}
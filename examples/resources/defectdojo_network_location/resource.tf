@@ -0,0 +1,10 @@
+variable "network_segments" {
+  type    = set(string)
+  default = ["10.0.0.0/16", "10.1.0.0/16"]
+}
+
+resource "defectdojo_network_location" "segment" {
+  for_each = var.network_segments
+
+  location = each.value
+}
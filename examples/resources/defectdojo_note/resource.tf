@@ -0,0 +1,6 @@
+resource "defectdojo_note" "sign_off" {
+  target_type = "engagement"
+  target_id   = defectdojo_engagement.release.id
+  entry       = "Release 1.2 signed off by the product owner"
+  note_type   = defectdojo_note_type.sign_off.id
+}
@@ -0,0 +1,6 @@
+resource "defectdojo_note_type" "sign_off" {
+  name         = "Sign-off"
+  description  = "Sign-off of the engagement by the product owner"
+  is_single    = true
+  is_mandatory = false
+}
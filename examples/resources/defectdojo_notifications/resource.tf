@@ -0,0 +1,14 @@
+# system-wide notifications
+resource "defectdojo_notifications" "system" {
+  product_added = ["slack"]
+  sla_breach    = ["slack", "mail"]
+}
+
+# notifications of a user for a single product
+resource "defectdojo_notifications" "security_champion" {
+  user             = defectdojo_user.security_champion.id
+  product          = defectdojo_product.web_shop.id
+  scan_added       = ["alert", "mail"]
+  engagement_added = ["alert"]
+  sla_breach       = ["mail"]
+}
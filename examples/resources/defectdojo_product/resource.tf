@@ -9,4 +9,9 @@ resource "defectdojo_product" "test_product" {
   name        = "Test Product"
   description = "This is the description of the Test Product"
   prod_type   = defectdojo_product_type.test_product_type.id
+
+  metadata = {
+    owner = "team-a"
+    repo  = "https://github.com/example/app"
+  }
 }
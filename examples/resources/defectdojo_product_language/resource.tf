@@ -0,0 +1,14 @@
+data "defectdojo_language_types" "all" {}
+
+locals {
+  language_types = { for language_type in data.defectdojo_language_types.all.language_types : language_type.language => language_type.id }
+}
+
+resource "defectdojo_product_language" "go" {
+  product  = defectdojo_product.web_shop.id
+  language = local.language_types["Go"]
+  files    = 120
+  code     = 15000
+  blank    = 1800
+  comment  = 2100
+}
@@ -0,0 +1,8 @@
+resource "defectdojo_product_technology" "nginx" {
+  product    = defectdojo_product.web_shop.id
+  name       = "nginx"
+  version    = "1.27.0"
+  confidence = 100
+  website    = "https://nginx.org"
+  user       = defectdojo_user.sbom_pipeline.id
+}
@@ -0,0 +1,7 @@
+resource "defectdojo_regulation" "dora" {
+  name         = "Digital Operational Resilience Act"
+  acronym      = "DORA"
+  category     = "finance"
+  jurisdiction = "European Union"
+  reference    = "https://eur-lex.europa.eu/eli/reg/2022/2554/oj"
+}
@@ -0,0 +1,13 @@
+resource "defectdojo_risk_acceptance" "legacy_tls" {
+  name                   = "Legacy TLS on the payment gateway"
+  accepted_findings      = [1234, 1235]
+  recommendation         = "M"
+  recommendation_details = "Restrict access to the payment provider IP ranges"
+  decision               = "A"
+  decision_details       = "Approved in SEC-123"
+  accepted_by            = "CISO"
+  owner                  = defectdojo_user.security_lead.id
+  expiration_date        = "2099-12-31"
+  reactivate_expired     = true
+  restart_sla_expired    = true
+}
@@ -0,0 +1,20 @@
+resource "defectdojo_sla_configuration" "strict" {
+  name        = "Strict"
+  description = "SLAs for internet-facing products"
+  critical    = 3
+  high        = 14
+  medium      = 60
+  low         = 90
+  enforce_low = false
+}
+
+resource "defectdojo_product_type" "test_product_type" {
+  name = "Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+  name              = "Test Product"
+  description       = "This is the description of the Test Product"
+  prod_type         = defectdojo_product_type.test_product_type.id
+  sla_configuration = defectdojo_sla_configuration.strict.id
+}
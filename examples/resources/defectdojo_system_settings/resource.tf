@@ -0,0 +1,14 @@
+resource "defectdojo_system_settings" "this" {
+  enable_deduplication   = true
+  delete_duplicates      = true
+  max_dupes              = 10
+  false_positive_history = true
+
+  enable_finding_sla       = true
+  enable_notify_sla_active = true
+
+  enable_jira           = true
+  jira_minimum_severity = "Medium"
+
+  enable_product_grade = true
+}
@@ -0,0 +1,5 @@
+resource "defectdojo_test_type" "custom_scanner" {
+  name        = "Custom Secret Scanner"
+  static_tool = true
+  tags        = ["custom"]
+}
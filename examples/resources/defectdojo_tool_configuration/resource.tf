@@ -0,0 +1,30 @@
+variable "gitlab_api_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "defectdojo_tool_type" "gitlab" {
+  name = "GitLab"
+}
+
+resource "defectdojo_tool_configuration" "gitlab" {
+  name                = "Company GitLab"
+  url                 = "https://gitlab.example.com"
+  tool_type           = defectdojo_tool_type.gitlab.id
+  authentication_type = "API"
+  auth_title          = "defectdojo"
+  api_key             = var.gitlab_api_token
+  credentials_version = 1
+}
+
+# reference the tool configuration from a CI/CD engagement
+resource "defectdojo_engagement" "pipeline" {
+  name                          = "GitLab Pipeline"
+  product                       = 1
+  target_start                  = "2024-01-01"
+  target_end                    = "2024-12-31"
+  engagement_type               = "CI/CD"
+  build_server                  = defectdojo_tool_configuration.gitlab.id
+  source_code_management_server = defectdojo_tool_configuration.gitlab.id
+}
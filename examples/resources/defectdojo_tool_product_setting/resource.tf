@@ -0,0 +1,17 @@
+resource "defectdojo_tool_type" "gitlab" {
+  name = "GitLab"
+}
+
+resource "defectdojo_tool_configuration" "gitlab" {
+  name      = "Company GitLab"
+  url       = "https://gitlab.example.com"
+  tool_type = defectdojo_tool_type.gitlab.id
+}
+
+resource "defectdojo_tool_product_setting" "gitlab" {
+  name               = "Web Shop Repository"
+  url                = "https://gitlab.example.com/shop/web-shop"
+  product            = 1
+  tool_configuration = defectdojo_tool_configuration.gitlab.id
+  tool_project_id    = "42"
+}
@@ -0,0 +1,4 @@
+resource "defectdojo_tool_type" "gitlab" {
+  name        = "GitLab"
+  description = "GitLab source code management and CI/CD"
+}
@@ -5,4 +5,13 @@ resource "defectdojo_user" "test_user" {
   email        = "terraform@provider.com"
   is_active    = true
   is_superuser = false
+
+  password         = var.password
+  password_version = 1
+
+  contact_info = {
+    title          = "Security Engineer"
+    slack_username = "first.last"
+    slack_user_id  = "U012AB3CD"
+  }
 }
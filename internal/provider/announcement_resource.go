@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &announcementResource{}
+	_ resource.ResourceWithConfigure   = &announcementResource{}
+	_ resource.ResourceWithImportState = &announcementResource{}
+)
+
+// NewAnnouncementResource is a helper function to simplify the provider implementation.
+func NewAnnouncementResource() resource.Resource {
+	return &announcementResource{}
+}
+
+// announcementResource is the resource implementation.
+type announcementResource struct {
+	client *defectdojo.APIClient
+}
+
+type announcementResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Message     types.String `tfsdk:"message"`
+	Style       types.String `tfsdk:"style"`
+	Dismissable types.Bool   `tfsdk:"dismissable"`
+}
+
+// Metadata returns the resource type name.
+func (r *announcementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_announcement"
+}
+
+// Schema defines the schema for the resource.
+func (r *announcementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the announcement banner of Defectdojo. Only a single announcement can exist, so an existing announcement is adopted on creation. Destroying this resource removes the banner",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the announcement",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "The message of the announcement banner. May contain HTML",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"style": schema.StringAttribute{
+				Description: "The style of the announcement banner. Valid values are: info, success, warning, danger",
+				Computed:    true,
+				Optional:    true,
+				Default:     stringdefault.StaticString("info"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("info", "success", "warning", "danger"),
+				},
+			},
+			"dismissable": schema.BoolAttribute{
+				Description: "Whether users can dismiss the announcement banner",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *announcementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *announcementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan announcementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	announcementRequest := announcementRequestFromPlan(plan)
+
+	// Look up an existing announcement, Defectdojo only shows a single one
+	announcementList, res, err := r.client.AnnouncementsAPI.AnnouncementsList(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Announcement",
+			"Could not read announcements, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	var announcement *defectdojo.Announcement
+	if len(announcementList.Results) > 0 {
+		// Apply the configured values to the existing announcement
+		id := announcementList.Results[0].GetId()
+		announcement, res, err = r.client.AnnouncementsAPI.AnnouncementsUpdate(ctx, id).AnnouncementRequest(announcementRequest).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Defectdojo Announcement",
+				"Could not update announcement with ID "+strconv.Itoa(int(id))+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+			)
+			return
+		}
+	} else {
+		// Create new announcement
+		announcement, res, err = r.client.AnnouncementsAPI.AnnouncementsCreate(ctx).AnnouncementRequest(announcementRequest).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Defectdojo Announcement",
+				"Could not create announcement, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(announcement.GetId()))
+	mapAnnouncementToModel(announcement, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *announcementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state announcementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed announcement value from Defectdojo
+	announcement, res, err := r.client.AnnouncementsAPI.AnnouncementsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Announcement",
+			"Could not read announcement with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(announcement.GetId()))
+	mapAnnouncementToModel(announcement, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *announcementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan announcementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	announcementRequest := announcementRequestFromPlan(plan)
+
+	// Update existing announcement
+	_, res, err := r.client.AnnouncementsAPI.AnnouncementsUpdate(ctx, int32(plan.ID.ValueInt64())).AnnouncementRequest(announcementRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Announcement",
+			"Could not update announcement with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed announcement value from Defectdojo
+	announcement, res, err := r.client.AnnouncementsAPI.AnnouncementsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Announcement",
+			"Could not read announcement with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(announcement.GetId()))
+	mapAnnouncementToModel(announcement, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *announcementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state announcementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing announcement
+	res, err := r.client.AnnouncementsAPI.AnnouncementsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Announcement",
+			"Could not delete announcement, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *announcementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// announcementRequestFromPlan generates the request for creating and updating the announcement.
+func announcementRequestFromPlan(plan announcementResourceModel) defectdojo.AnnouncementRequest {
+	return defectdojo.AnnouncementRequest{
+		Message:     plan.Message.ValueString(),
+		Style:       plan.Style.ValueStringPointer(),
+		Dismissable: plan.Dismissable.ValueBoolPointer(),
+	}
+}
+
+// mapAnnouncementToModel maps the announcement to the model.
+func mapAnnouncementToModel(announcement *defectdojo.Announcement, model *announcementResourceModel) {
+	model.Message = types.StringValue(announcement.GetMessage())
+	model.Style = types.StringValue(announcement.GetStyle())
+	model.Dismissable = types.BoolValue(announcement.GetDismissable())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAnnouncementResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_announcement" "test" {
+					message = "Maintenance in progress"
+					style   = "error"
+				}
+			`,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_announcement" "test" {
+					message = "Maintenance in progress"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_announcement.test", "message", "Maintenance in progress"),
+
+					// Verify default values
+					resource.TestCheckResourceAttr("defectdojo_announcement.test", "style", "info"),
+					resource.TestCheckResourceAttr("defectdojo_announcement.test", "dismissable", "false"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_announcement.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_announcement.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_announcement" "test" {
+					message     = "Maintenance until 18:00 UTC, imports are paused"
+					style       = "warning"
+					dismissable = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_announcement.test", "message", "Maintenance until 18:00 UTC, imports are paused"),
+					resource.TestCheckResourceAttr("defectdojo_announcement.test", "style", "warning"),
+					resource.TestCheckResourceAttr("defectdojo_announcement.test", "dismissable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// fetchAPIToken fetches the API token of a user with its username and password.
+// Defectdojo creates a token if the user does not have one yet.
+func fetchAPIToken(ctx context.Context, httpClient *http.Client, host string, userAgent string, headers map[string]string, username string, password string) (string, error) {
+	// we have to go oldschool here because the openapi definition for the api token endpoint is not working
+	body, err := json.Marshal(map[string]string{
+		"username": username,
+		"password": password,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Create a HTTP post request
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(host, "/")+"/api/v2/api-token-auth/", bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+
+	for name, value := range headers {
+		r.Header.Set(name, value)
+	}
+
+	r.Header.Add("Content-Type", "application/json")
+	r.Header.Add("user-agent", userAgent)
+
+	res, err := httpClient.Do(r)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("defectdojo responded with status %d, check the username and password", res.StatusCode)
+	}
+
+	response := struct {
+		Token string `json:"token"`
+	}{}
+
+	err = json.NewDecoder(res.Body).Decode(&response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Token, nil
+}
+
+// resetAPIToken replaces the API token of a user with a new one, which invalidates the old token.
+// the Defectdojo API only allows users to reset their own token, so we have to go through
+// the token forms of the Django admin.
+func resetAPIToken(ctx context.Context, providerData *DefectdojoProviderData, userID int32) error {
+	admin, err := newDjangoAdminClient(ctx, providerData)
+	if err != nil {
+		return err
+	}
+
+	// tokens are identified by the id of their user in the Django admin.
+	// django redirects as well if the user does not have a token yet.
+	err = admin.submit(ctx, fmt.Sprintf("/admin/authtoken/tokenproxy/%d/delete/", userID), url.Values{
+		"post": {"yes"},
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete the current token: %w", err)
+	}
+
+	err = admin.submit(ctx, "/admin/authtoken/tokenproxy/add/", url.Values{
+		"user":  {fmt.Sprintf("%d", userID)},
+		"_save": {"Save"},
+	})
+	// tokens are unique per user, so the new token can only be created after the old one is gone
+	if err != nil {
+		return fmt.Errorf("the current token was deleted, but no new token could be created, so the user has no API token until the token is reset again: %w", err)
+	}
+
+	return nil
+}
+
+// apiTokenExists returns whether a user has an API token.
+func apiTokenExists(ctx context.Context, providerData *DefectdojoProviderData, userID int32) (bool, error) {
+	admin, err := newDjangoAdminClient(ctx, providerData)
+	if err != nil {
+		return false, err
+	}
+
+	return admin.exists(ctx, fmt.Sprintf("/admin/authtoken/tokenproxy/%d/change/", userID))
+}
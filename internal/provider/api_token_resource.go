@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiTokenResource{}
+	_ resource.ResourceWithConfigure   = &apiTokenResource{}
+	_ resource.ResourceWithImportState = &apiTokenResource{}
+)
+
+// NewAPITokenResource is a helper function to simplify the provider implementation.
+func NewAPITokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+// apiTokenResource is the resource implementation.
+// the token itself is never stored in the state, use the ephemeral resource to fetch it.
+type apiTokenResource struct {
+	client       *defectdojo.APIClient
+	providerData *DefectdojoProviderData
+}
+
+type apiTokenResourceModel struct {
+	ID              types.Int64 `tfsdk:"id"`
+	User            types.Int64 `tfsdk:"user"`
+	RotationVersion types.Int64 `tfsdk:"rotation_version"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier of the user the token belongs to",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.Int64Attribute{
+				Description: "The unique identifier of the user whose token is reset when the resource is created. Requires the provider to be configured with the username and password of a superuser, which is also used to check that the token still exists",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_version": schema.Int64Attribute{
+				Description: "Changing this value resets the token of the user again, which invalidates the previous token",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Create resets the token and sets the initial Terraform state.
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan apiTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reset the token of the user
+	err := resetAPIToken(ctx, r.providerData, int32(plan.User.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting Defectdojo API Token",
+			"Could not reset API token of user with ID "+plan.User.String()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.User
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state apiTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make sure the user of the token still exists
+	user, res, err := r.client.UsersAPI.UsersRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo User",
+			"Could not read user with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Make sure the user still has a token, a missing token is reset again on the next apply
+	exists, err := apiTokenExists(ctx, r.providerData, user.GetId())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo API Token",
+			"Could not read API token of user with ID "+state.ID.String()+": "+err.Error(),
+		)
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(user.GetId()))
+	state.User = types.Int64Value(int64(user.GetId()))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update resets the token again if the rotation version changed.
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan apiTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state apiTokenResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotationVersion.Equal(state.RotationVersion) {
+		err := resetAPIToken(ctx, r.providerData, int32(plan.User.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resetting Defectdojo API Token",
+				"Could not reset API token of user with ID "+plan.User.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Terraform state.
+// the token is left untouched so services using it keep working.
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *apiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
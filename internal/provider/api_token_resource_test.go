@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPITokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_user" "test" {
+					username = "TokenUser"
+					email	 = "token@email.com"
+				}
+
+				resource "defectdojo_api_token" "test" {
+					user = defectdojo_user.test.id
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_api_token.test", "id", "defectdojo_user.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_api_token.test", "user", "defectdojo_user.test", "id"),
+					resource.TestCheckNoResourceAttr("defectdojo_api_token.test", "rotation_version"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_api_token.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_user" "test" {
+					username = "TokenUser"
+					email	 = "token@email.com"
+				}
+
+				resource "defectdojo_api_token" "test" {
+					user             = defectdojo_user.test.id
+					rotation_version = 1
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_api_token.test", "rotation_version", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPITokenAuth(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v2/api-token-auth/", r.URL.Path)
+
+		credentials := struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&credentials))
+
+		if credentials.Username != "user" || credentials.Password != `pass"word` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, err := w.Write([]byte(`{"token": "secret"}`))
+		require.NoError(t, err)
+	}))
+}
+
+func TestUnitFetchAPIToken(t *testing.T) {
+	server := newTestAPITokenAuth(t)
+	defer server.Close()
+
+	token, err := fetchAPIToken(context.Background(), server.Client(), server.URL+"/", "test", nil, "user", `pass"word`)
+
+	require.NoError(t, err)
+	require.Equal(t, "secret", token)
+}
+
+func TestUnitFetchAPITokenPathPrefix(t *testing.T) {
+	server := newTestAPITokenAuth(t)
+	defer server.Close()
+
+	proxy := httptest.NewServer(http.StripPrefix("/defectdojo", server.Config.Handler))
+	defer proxy.Close()
+
+	token, err := fetchAPIToken(context.Background(), proxy.Client(), proxy.URL+"/defectdojo", "test", nil, "user", `pass"word`)
+
+	require.NoError(t, err)
+	require.Equal(t, "secret", token)
+}
+
+func TestUnitFetchAPITokenHeaders(t *testing.T) {
+	server := newTestAPITokenAuth(t)
+	defer server.Close()
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Proxy-Auth") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	_, err := fetchAPIToken(context.Background(), proxy.Client(), proxy.URL, "test", nil, "user", `pass"word`)
+	require.Error(t, err)
+
+	token, err := fetchAPIToken(context.Background(), proxy.Client(), proxy.URL, "test", map[string]string{"X-Proxy-Auth": "secret"}, "user", `pass"word`)
+	require.NoError(t, err)
+	require.Equal(t, "secret", token)
+}
+
+func TestUnitFetchAPITokenInvalidCredentials(t *testing.T) {
+	server := newTestAPITokenAuth(t)
+	defer server.Close()
+
+	_, err := fetchAPIToken(context.Background(), server.Client(), server.URL, "test", nil, "user", "wrong")
+
+	require.Error(t, err)
+}
+
+func TestUnitResetAPIToken(t *testing.T) {
+	server, mux := newTestDjangoAdmin(t, map[string]string{"admin": "adminPassword"})
+	defer server.Close()
+
+	tokens := map[string]string{"2": "old"}
+
+	mux.HandleFunc("/admin/authtoken/tokenproxy/2/delete/", func(w http.ResponseWriter, r *http.Request) {
+		requireTestDjangoAdminSession(t, r)
+		require.Equal(t, "yes", r.PostForm.Get("post"))
+
+		delete(tokens, "2")
+		http.Redirect(w, r, "/admin/authtoken/tokenproxy/", http.StatusFound)
+	})
+
+	mux.HandleFunc("/admin/authtoken/tokenproxy/add/", func(w http.ResponseWriter, r *http.Request) {
+		requireTestDjangoAdminSession(t, r)
+
+		if _, ok := tokens[r.PostForm.Get("user")]; ok {
+			return
+		}
+
+		tokens[r.PostForm.Get("user")] = "new"
+		http.Redirect(w, r, "/admin/authtoken/tokenproxy/", http.StatusFound)
+	})
+
+	providerData := &DefectdojoProviderData{
+		HTTPClient: server.Client(),
+		Host:       server.URL,
+		Username:   "admin",
+		Password:   "adminPassword",
+	}
+
+	err := resetAPIToken(context.Background(), providerData, 2)
+
+	require.NoError(t, err)
+	require.Equal(t, "new", tokens["2"])
+}
+
+func TestUnitResetAPITokenCreateFails(t *testing.T) {
+	server, mux := newTestDjangoAdmin(t, map[string]string{"admin": "adminPassword"})
+	defer server.Close()
+
+	mux.HandleFunc("/admin/authtoken/tokenproxy/2/delete/", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/admin/authtoken/tokenproxy/", http.StatusFound)
+	})
+
+	// django renders the form again if it can't save it
+	mux.HandleFunc("/admin/authtoken/tokenproxy/add/", func(w http.ResponseWriter, r *http.Request) {})
+
+	providerData := &DefectdojoProviderData{
+		HTTPClient: server.Client(),
+		Host:       server.URL,
+		Username:   "admin",
+		Password:   "adminPassword",
+	}
+
+	err := resetAPIToken(context.Background(), providerData, 2)
+
+	require.ErrorContains(t, err, "the user has no API token")
+}
+
+func TestUnitAPITokenExists(t *testing.T) {
+	server, mux := newTestDjangoAdmin(t, map[string]string{"admin": "adminPassword"})
+	defer server.Close()
+
+	mux.HandleFunc("/admin/authtoken/tokenproxy/2/change/", func(w http.ResponseWriter, r *http.Request) {
+		_, err := r.Cookie("sessionid")
+		require.NoError(t, err)
+	})
+
+	mux.HandleFunc("/admin/authtoken/tokenproxy/3/change/", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/admin/", http.StatusFound)
+	})
+
+	providerData := &DefectdojoProviderData{
+		HTTPClient: server.Client(),
+		Host:       server.URL,
+		Username:   "admin",
+		Password:   "adminPassword",
+	}
+
+	exists, err := apiTokenExists(context.Background(), providerData, 2)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = apiTokenExists(context.Background(), providerData, 3)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// authTransport adds the token to every request sent to the Defectdojo API.
+// scheme defaults to Token, the scheme of Defectdojo API tokens.
+// if Defectdojo rejects the token and a login function is set, a new token
+// is fetched and the request is sent again.
+type authTransport struct {
+	base   http.RoundTripper
+	login  func(ctx context.Context) (string, error)
+	scheme string
+
+	mu    sync.Mutex
+	token string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.currentToken()
+
+	res, err := t.base.RoundTrip(t.authorize(req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized || t.login == nil {
+		return res, err
+	}
+
+	// we can't send the request again if the body can't be read a second time
+	if req.Body != nil && req.GetBody == nil {
+		return res, nil
+	}
+
+	newToken, err := t.refresh(req.Context(), token)
+	if err != nil {
+		return res, nil
+	}
+
+	retry := t.authorize(req, newToken)
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return res, nil
+		}
+	}
+
+	res.Body.Close()
+
+	return t.base.RoundTrip(retry)
+}
+
+// authorize returns a copy of the request with the token set.
+func (t *authTransport) authorize(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	scheme := t.scheme
+	if scheme == "" {
+		scheme = "Token"
+	}
+
+	r.Header.Set("Authorization", scheme+" "+token)
+
+	return r
+}
+
+func (t *authTransport) currentToken() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.token
+}
+
+// refresh fetches a new token unless another request already replaced the rejected one.
+func (t *authTransport) refresh(ctx context.Context, rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != rejected {
+		return t.token, nil
+	}
+
+	token, err := t.login(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = token
+
+	return token, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAuthServer returns a server that only accepts the given token and echoes the request body.
+func newTestAuthServer(t *testing.T, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		_, err = w.Write(body)
+		require.NoError(t, err)
+	}))
+}
+
+func TestUnitAuthTransport(t *testing.T) {
+	server := newTestAuthServer(t, "valid")
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &authTransport{
+			base:  http.DefaultTransport,
+			token: "valid",
+		},
+	}
+
+	res, err := client.Post(server.URL, "text/plain", bytes.NewBufferString("body"))
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestUnitAuthTransportBearer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer valid", r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &authTransport{
+			base:   http.DefaultTransport,
+			scheme: "Bearer",
+			token:  "valid",
+		},
+	}
+
+	res, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestUnitAuthTransportLoginOnUnauthorized(t *testing.T) {
+	server := newTestAuthServer(t, "valid")
+	defer server.Close()
+
+	logins := 0
+	transport := &authTransport{
+		base: http.DefaultTransport,
+		login: func(ctx context.Context) (string, error) {
+			logins++
+			return "valid", nil
+		},
+		token: "expired",
+	}
+	client := &http.Client{Transport: transport}
+
+	for range 2 {
+		res, err := client.Post(server.URL, "text/plain", bytes.NewBufferString("body"))
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "body", string(body))
+	}
+
+	require.Equal(t, 1, logins)
+	require.Equal(t, "valid", transport.currentToken())
+}
+
+func TestUnitAuthTransportWithoutLogin(t *testing.T) {
+	server := newTestAuthServer(t, "valid")
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &authTransport{
+			base:  http.DefaultTransport,
+			token: "expired",
+		},
+	}
+
+	res, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &credentialMappingResource{}
+	_ resource.ResourceWithConfigure   = &credentialMappingResource{}
+	_ resource.ResourceWithImportState = &credentialMappingResource{}
+)
+
+// NewCredentialMappingResource is a helper function to simplify the provider implementation.
+func NewCredentialMappingResource() resource.Resource {
+	return &credentialMappingResource{}
+}
+
+// credentialMappingResource is the resource implementation.
+type credentialMappingResource struct {
+	client *defectdojo.APIClient
+}
+
+type credentialMappingResourceModel struct {
+	ID              types.Int64 `tfsdk:"id"`
+	Credential      types.Int64 `tfsdk:"credential"`
+	Product         types.Int64 `tfsdk:"product"`
+	Engagement      types.Int64 `tfsdk:"engagement"`
+	Test            types.Int64 `tfsdk:"test"`
+	Finding         types.Int64 `tfsdk:"finding"`
+	URLCredentials  types.Bool  `tfsdk:"url_credentials"`
+	IsAuthnProvider types.Bool  `tfsdk:"is_authn_provider"`
+}
+
+// Metadata returns the resource type name.
+func (r *credentialMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_mapping"
+}
+
+// Schema defines the schema for the resource.
+func (r *credentialMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the credential mapping",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential": schema.Int64Attribute{
+				Description: "The ID of the credential",
+				Required:    true,
+			},
+			"product": schema.Int64Attribute{
+				Description: "The ID of the product the credential is mapped to. Exactly one of product, engagement, test or finding must be set",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(
+						path.MatchRoot("product"),
+						path.MatchRoot("engagement"),
+						path.MatchRoot("test"),
+						path.MatchRoot("finding"),
+					),
+				},
+			},
+			"engagement": schema.Int64Attribute{
+				Description: "The ID of the engagement the credential is mapped to",
+				Optional:    true,
+			},
+			"test": schema.Int64Attribute{
+				Description: "The ID of the test the credential is mapped to",
+				Optional:    true,
+			},
+			"finding": schema.Int64Attribute{
+				Description: "The ID of the finding the credential is mapped to",
+				Optional:    true,
+			},
+			"url_credentials": schema.BoolAttribute{
+				Description: "Whether the credential is used for the URL of the target",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_authn_provider": schema.BoolAttribute{
+				Description: "Whether the credential is used for the authentication provider of the target",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *credentialMappingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *credentialMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan credentialMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	credentialMappingRequest := credentialMappingRequestFromPlan(plan)
+
+	// Create new credential mapping
+	credentialMapping, res, err := r.client.CredentialMappingsAPI.CredentialMappingsCreate(ctx).CredentialMappingRequest(credentialMappingRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Credential Mapping",
+			"Could not create credential mapping, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(credentialMapping.GetId()))
+	mapCredentialMappingToModel(credentialMapping, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *credentialMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state credentialMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed credential mapping value from Defectdojo
+	credentialMapping, res, err := r.client.CredentialMappingsAPI.CredentialMappingsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Credential Mapping",
+			"Could not read credential mapping with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(credentialMapping.GetId()))
+	mapCredentialMappingToModel(credentialMapping, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *credentialMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan credentialMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	credentialMappingRequest := credentialMappingRequestFromPlan(plan)
+
+	// Update existing credential mapping
+	_, res, err := r.client.CredentialMappingsAPI.CredentialMappingsUpdate(ctx, int32(plan.ID.ValueInt64())).CredentialMappingRequest(credentialMappingRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Credential Mapping",
+			"Could not update credential mapping with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed credential mapping value from Defectdojo
+	credentialMapping, res, err := r.client.CredentialMappingsAPI.CredentialMappingsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Credential Mapping",
+			"Could not read credential mapping with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(credentialMapping.GetId()))
+	mapCredentialMappingToModel(credentialMapping, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *credentialMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state credentialMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing credential mapping
+	res, err := r.client.CredentialMappingsAPI.CredentialMappingsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Credential Mapping",
+			"Could not delete credential mapping, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *credentialMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// credentialMappingRequestFromPlan generates the request for creating and updating a credential mapping.
+func credentialMappingRequestFromPlan(plan credentialMappingResourceModel) defectdojo.CredentialMappingRequest {
+	return defectdojo.CredentialMappingRequest{
+		CredId:          int32(plan.Credential.ValueInt64()),
+		Product:         basetypesInt64ValueToDefectdojoNullableInt32(plan.Product),
+		Engagement:      basetypesInt64ValueToDefectdojoNullableInt32(plan.Engagement),
+		Test:            basetypesInt64ValueToDefectdojoNullableInt32(plan.Test),
+		Finding:         basetypesInt64ValueToDefectdojoNullableInt32(plan.Finding),
+		UrlCredentials:  plan.URLCredentials.ValueBoolPointer(),
+		IsAuthnProvider: plan.IsAuthnProvider.ValueBoolPointer(),
+	}
+}
+
+// mapCredentialMappingToModel maps a credential mapping to the model.
+func mapCredentialMappingToModel(credentialMapping *defectdojo.CredentialMapping, model *credentialMappingResourceModel) {
+	model.Credential = types.Int64Value(int64(credentialMapping.GetCredId()))
+	model.Product = defectdojoNullableInt32ToBasetypesInt64Value(credentialMapping.Product)
+	model.Engagement = defectdojoNullableInt32ToBasetypesInt64Value(credentialMapping.Engagement)
+	model.Test = defectdojoNullableInt32ToBasetypesInt64Value(credentialMapping.Test)
+	model.Finding = defectdojoNullableInt32ToBasetypesInt64Value(credentialMapping.Finding)
+	model.URLCredentials = types.BoolValue(credentialMapping.GetUrlCredentials())
+	model.IsAuthnProvider = types.BoolValue(credentialMapping.GetIsAuthnProvider())
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCredentialMappingDependencies = `
+resource "defectdojo_development_environment" "test_environment" {
+	name = "Test Credential Mapping Environment"
+}
+
+resource "defectdojo_credential" "test_credential" {
+	name        = "Test Credential"
+	username    = "scanner"
+	password    = "password"
+	role        = "admin"
+	url         = "https://app.example.com/login"
+	environment = defectdojo_development_environment.test_environment.id
+}
+
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_engagement" "test_engagement" {
+	name         = "Test Engagement"
+	product      = defectdojo_product.test_product.id
+	target_start = "2024-01-01"
+	target_end   = "2024-01-31"
+}
+`
+
+func TestAccCredentialMappingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks:   testAccWriteOnlyVersionChecks,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + testAccCredentialMappingDependencies + `
+				resource "defectdojo_credential_mapping" "test" {
+					credential = defectdojo_credential.test_credential.id
+				}
+			`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccCredentialMappingDependencies + `
+				resource "defectdojo_credential_mapping" "test" {
+					credential = defectdojo_credential.test_credential.id
+					product    = defectdojo_product.test_product.id
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttrPair("defectdojo_credential_mapping.test", "credential", "defectdojo_credential.test_credential", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_credential_mapping.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttr("defectdojo_credential_mapping.test", "url_credentials", "false"),
+					resource.TestCheckResourceAttr("defectdojo_credential_mapping.test", "is_authn_provider", "false"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_credential_mapping.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_credential_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccCredentialMappingDependencies + `
+				resource "defectdojo_credential_mapping" "test" {
+					credential        = defectdojo_credential.test_credential.id
+					engagement        = defectdojo_engagement.test_engagement.id
+					is_authn_provider = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckNoResourceAttr("defectdojo_credential_mapping.test", "product"),
+					resource.TestCheckResourceAttrPair("defectdojo_credential_mapping.test", "engagement", "defectdojo_engagement.test_engagement", "id"),
+					resource.TestCheckResourceAttr("defectdojo_credential_mapping.test", "is_authn_provider", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
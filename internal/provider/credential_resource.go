@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &credentialResource{}
+	_ resource.ResourceWithConfigure   = &credentialResource{}
+	_ resource.ResourceWithImportState = &credentialResource{}
+)
+
+// NewCredentialResource is a helper function to simplify the provider implementation.
+func NewCredentialResource() resource.Resource {
+	return &credentialResource{}
+}
+
+// credentialResource is the resource implementation.
+type credentialResource struct {
+	client *defectdojo.APIClient
+}
+
+type credentialResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Role            types.String `tfsdk:"role"`
+	Authentication  types.String `tfsdk:"authentication"`
+	URL             types.String `tfsdk:"url"`
+	Environment     types.Int64  `tfsdk:"environment"`
+	LoginRegex      types.String `tfsdk:"login_regex"`
+	LogoutRegex     types.String `tfsdk:"logout_regex"`
+}
+
+// Metadata returns the resource type name.
+func (r *credentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// Schema defines the schema for the resource.
+func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the credential",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the credential",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the credential",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the credential. The value is write-only and never stored in the state, which requires Terraform 1.11 or later",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_version": schema.Int64Attribute{
+				Description: "Changing this value updates the credential with the current value of password. Defectdojo does not return the password, so this is the only way to detect a rotation",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user the credential belongs to, e.g. admin",
+				Required:    true,
+			},
+			"authentication": schema.StringAttribute{
+				Description: "The authentication method, one of Form or SSO",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Form", "SSO"),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL the credential is used for",
+				Required:    true,
+			},
+			"environment": schema.Int64Attribute{
+				Description: "The ID of the development environment the credential is used in",
+				Required:    true,
+			},
+			"login_regex": schema.StringAttribute{
+				Description: "A regular expression matching the response of a successful login",
+				Computed:    true,
+				Optional:    true,
+			},
+			"logout_regex": schema.StringAttribute{
+				Description: "A regular expression matching the response of a logout",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *credentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan credentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only values are only available in the config
+	var config credentialResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	credentialRequest := credentialRequestFromPlan(plan, config)
+
+	// Create new credential
+	credential, res, err := r.client.CredentialsAPI.CredentialsCreate(ctx).CredentialRequest(credentialRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Credential",
+			"Could not create credential, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(credential.GetId()))
+	mapCredentialToModel(credential, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state credentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed credential value from Defectdojo
+	credential, res, err := r.client.CredentialsAPI.CredentialsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Credential",
+			"Could not read credential with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(credential.GetId()))
+	mapCredentialToModel(credential, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan credentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only values are only available in the config
+	var config credentialResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	credentialRequest := credentialRequestFromPlan(plan, config)
+
+	// Update existing credential
+	_, res, err := r.client.CredentialsAPI.CredentialsUpdate(ctx, int32(plan.ID.ValueInt64())).CredentialRequest(credentialRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Credential",
+			"Could not update credential with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed credential value from Defectdojo
+	credential, res, err := r.client.CredentialsAPI.CredentialsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Credential",
+			"Could not read credential with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(credential.GetId()))
+	mapCredentialToModel(credential, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state credentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing credential
+	res, err := r.client.CredentialsAPI.CredentialsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Credential",
+			"Could not delete credential, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// credentialRequestFromPlan generates the request for creating and updating a credential.
+// the write-only password is taken from the config as it is always null in the plan.
+func credentialRequestFromPlan(plan credentialResourceModel, config credentialResourceModel) defectdojo.CredentialRequest {
+	return defectdojo.CredentialRequest{
+		Name:           plan.Name.ValueString(),
+		Username:       plan.Username.ValueString(),
+		Password:       config.Password.ValueString(),
+		Role:           plan.Role.ValueString(),
+		Authentication: basetypesStringValueToStringPointer(plan.Authentication),
+		Url:            plan.URL.ValueString(),
+		Environment:    int32(plan.Environment.ValueInt64()),
+		LoginRegex:     basetypesStringValueToDefectdojoNullableString(plan.LoginRegex),
+		LogoutRegex:    basetypesStringValueToDefectdojoNullableString(plan.LogoutRegex),
+	}
+}
+
+// mapCredentialToModel maps a credential to the model.
+// the password is left untouched as Defectdojo never returns it.
+func mapCredentialToModel(credential *defectdojo.Credential, model *credentialResourceModel) {
+	model.Name = types.StringValue(credential.GetName())
+	model.Username = types.StringValue(credential.GetUsername())
+	model.Role = types.StringValue(credential.GetRole())
+	model.Authentication = stringPointerToBasetypesStringValue(credential.Authentication)
+	model.URL = types.StringValue(credential.GetUrl())
+	model.Environment = types.Int64Value(int64(credential.GetEnvironment()))
+	model.LoginRegex = defectdojoNullableStringToBasetypesStringValue(credential.LoginRegex)
+	model.LogoutRegex = defectdojoNullableStringToBasetypesStringValue(credential.LogoutRegex)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCredentialDependencies = `
+resource "defectdojo_development_environment" "test_environment" {
+	name = "Test Credential Environment"
+}
+`
+
+func TestAccCredentialResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks:   testAccWriteOnlyVersionChecks,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccCredentialDependencies + `
+				resource "defectdojo_credential" "test" {
+					name        = "Test Credential"
+					username    = "scanner"
+					password    = "password"
+					role        = "admin"
+					url         = "https://app.example.com/login"
+					environment = defectdojo_development_environment.test_environment.id
+					login_regex = "Welcome"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "name", "Test Credential"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "username", "scanner"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "role", "admin"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "url", "https://app.example.com/login"),
+					resource.TestCheckResourceAttrPair("defectdojo_credential.test", "environment", "defectdojo_development_environment.test_environment", "id"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "login_regex", "Welcome"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "authentication", "Form"),
+
+					// Verify write-only values are not stored in the state
+					resource.TestCheckNoResourceAttr("defectdojo_credential.test", "password"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_credential.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_credential.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccCredentialDependencies + `
+				resource "defectdojo_credential" "test" {
+					name             = "Test Credential"
+					username         = "scanner"
+					password         = "rotated-password"
+					password_version = 2
+					role             = "admin"
+					authentication   = "SSO"
+					url              = "https://app.example.com/login"
+					environment      = defectdojo_development_environment.test_environment.id
+					login_regex      = "Welcome"
+					logout_regex     = "Signed out"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "password_version", "2"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "authentication", "SSO"),
+					resource.TestCheckResourceAttr("defectdojo_credential.test", "logout_regex", "Signed out"),
+					resource.TestCheckNoResourceAttr("defectdojo_credential.test", "password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DevelopmentEnvironmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &DevelopmentEnvironmentDataSource{}
+)
+
+func NewDevelopmentEnvironmentDataSource() datasource.DataSource {
+	return &DevelopmentEnvironmentDataSource{}
+}
+
+// DevelopmentEnvironmentDataSource defines the data source implementation.
+type DevelopmentEnvironmentDataSource struct {
+	client *defectdojo.APIClient
+}
+
+// DevelopmentEnvironmentDataSourceModel describes the data source data model.
+type DevelopmentEnvironmentDataSourceModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// Metadata returns the data source type name.
+func (d *DevelopmentEnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_development_environment"
+}
+
+// Schema defines the schema for the data source.
+func (d *DevelopmentEnvironmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the development environment",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the development environment to look up",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DevelopmentEnvironmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DevelopmentEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DevelopmentEnvironmentDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	developmentEnvironment, res, err := findInPages(func(offset int32) ([]defectdojo.DevelopmentEnvironment, bool, *http.Response, error) {
+		developmentEnvironments, res, err := d.client.DevelopmentEnvironmentsAPI.DevelopmentEnvironmentsList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return developmentEnvironments.Results, developmentEnvironments.Next.Get() != nil, res, nil
+	}, func(developmentEnvironment *defectdojo.DevelopmentEnvironment) bool {
+		return developmentEnvironment.GetName() == state.Name.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Development Environments",
+			"Could not read development environments, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	if developmentEnvironment == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Development Environment Not Found",
+			"Could not find a development environment with the name "+state.Name.String(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.Int64Value(int64(developmentEnvironment.GetId()))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
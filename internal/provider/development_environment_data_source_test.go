@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevelopmentEnvironmentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "defectdojo_development_environment" "test" {
+					name = "Development"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the default development environment was found by its name
+					resource.TestCheckResourceAttrSet("data.defectdojo_development_environment.test", "id"),
+				),
+			},
+		},
+	})
+}
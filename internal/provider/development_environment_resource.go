@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &developmentEnvironmentResource{}
+	_ resource.ResourceWithConfigure   = &developmentEnvironmentResource{}
+	_ resource.ResourceWithImportState = &developmentEnvironmentResource{}
+)
+
+// NewDevelopmentEnvironmentResource is a helper function to simplify the provider implementation.
+func NewDevelopmentEnvironmentResource() resource.Resource {
+	return &developmentEnvironmentResource{}
+}
+
+// developmentEnvironmentResource is the resource implementation.
+type developmentEnvironmentResource struct {
+	client *defectdojo.APIClient
+}
+
+type developmentEnvironmentResourceModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// Metadata returns the resource type name.
+func (r *developmentEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_development_environment"
+}
+
+// Schema defines the schema for the resource.
+func (r *developmentEnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the development environment",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the development environment, e.g. Production or Staging",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *developmentEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *developmentEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan developmentEnvironmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	developmentEnvironmentRequest := defectdojo.DevelopmentEnvironmentRequest{
+		Name: plan.Name.ValueString(),
+	}
+
+	// Create new development environment
+	developmentEnvironment, res, err := r.client.DevelopmentEnvironmentsAPI.DevelopmentEnvironmentsCreate(ctx).DevelopmentEnvironmentRequest(developmentEnvironmentRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Development Environment",
+			"Could not create development environment, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(developmentEnvironment.GetId()))
+	plan.Name = types.StringValue(developmentEnvironment.GetName())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *developmentEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state developmentEnvironmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed development environment value from Defectdojo
+	developmentEnvironment, res, err := r.client.DevelopmentEnvironmentsAPI.DevelopmentEnvironmentsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Development Environment",
+			"Could not read development environment with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(developmentEnvironment.GetId()))
+	state.Name = types.StringValue(developmentEnvironment.GetName())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *developmentEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan developmentEnvironmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	developmentEnvironmentRequest := defectdojo.DevelopmentEnvironmentRequest{
+		Name: plan.Name.ValueString(),
+	}
+
+	// Update existing development environment
+	_, res, err := r.client.DevelopmentEnvironmentsAPI.DevelopmentEnvironmentsUpdate(ctx, int32(plan.ID.ValueInt64())).DevelopmentEnvironmentRequest(developmentEnvironmentRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Development Environment",
+			"Could not update development environment with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed development environment value from Defectdojo
+	developmentEnvironment, res, err := r.client.DevelopmentEnvironmentsAPI.DevelopmentEnvironmentsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Development Environment",
+			"Could not read development environment with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(developmentEnvironment.GetId()))
+	plan.Name = types.StringValue(developmentEnvironment.GetName())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *developmentEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state developmentEnvironmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing development environment
+	res, err := r.client.DevelopmentEnvironmentsAPI.DevelopmentEnvironmentsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Development Environment",
+			"Could not delete development environment, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *developmentEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDevelopmentEnvironmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_development_environment" "test" {
+					name = "Test Development Environment"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_development_environment.test", "name", "Test Development Environment"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_development_environment.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_development_environment.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_development_environment" "test" {
+					name = "Test Development Environment Updated"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_development_environment.test", "name", "Test Development Environment Updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
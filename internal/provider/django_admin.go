@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+)
+
+// djangoAdminClient submits forms of the Django admin.
+// we need it for the few operations the Defectdojo API does not offer,
+// which is why it requires a session of a superuser.
+type djangoAdminClient struct {
+	client    *http.Client
+	host      string
+	userAgent string
+	headers   map[string]string
+}
+
+// newDjangoAdminClient logs in to the Django admin with the credentials of the provider.
+func newDjangoAdminClient(ctx context.Context, providerData *DefectdojoProviderData) (*djangoAdminClient, error) {
+	if providerData.Username == "" || providerData.Password == "" {
+		return nil, errors.New("this operation requires the provider to be configured with the username and password of a superuser, a token is not sufficient")
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &djangoAdminClient{
+		client: &http.Client{
+			Transport: providerData.HTTPClient.Transport,
+			Jar:       jar,
+			// django answers successful form submissions with a redirect which we don't need to follow
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		host:      strings.TrimSuffix(providerData.Host, "/"),
+		userAgent: providerData.UserAgent,
+		headers:   providerData.Headers,
+	}
+
+	// the login page sets the csrf cookie we need for all following requests
+	if _, err := c.request(ctx, http.MethodGet, "/admin/login/", nil); err != nil {
+		return nil, err
+	}
+
+	status, err := c.request(ctx, http.MethodPost, "/admin/login/", url.Values{
+		"username": {providerData.Username},
+		"password": {providerData.Password},
+		"next":     {"/admin/"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status != http.StatusFound {
+		return nil, fmt.Errorf("could not log in to the Django admin (status %d), make sure the provider user is a superuser and the Django admin is enabled", status)
+	}
+
+	return c, nil
+}
+
+// submit posts a form and returns an error if django did not accept it.
+func (c *djangoAdminClient) submit(ctx context.Context, path string, form url.Values) error {
+	status, err := c.request(ctx, http.MethodPost, path, form)
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusFound {
+		return fmt.Errorf("the Django admin did not accept the form submitted to %s (status %d)", path, status)
+	}
+
+	return nil
+}
+
+// exists returns whether the change page of an object can be opened.
+// django redirects to the admin index for objects that don't exist.
+func (c *djangoAdminClient) exists(ctx context.Context, path string) (bool, error) {
+	status, err := c.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch status {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusFound, http.StatusNotFound:
+		return false, nil
+	}
+
+	return false, fmt.Errorf("the Django admin responded to %s with status %d", path, status)
+}
+
+// page returns the HTML of a page of the Django admin.
+func (c *djangoAdminClient) page(ctx context.Context, path string) (string, error) {
+	res, err := c.send(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("the Django admin responded to %s with status %d", path, res.StatusCode)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// request sends a request to the Django admin and returns the status code of the response.
+func (c *djangoAdminClient) request(ctx context.Context, method string, path string, form url.Values) (int, error) {
+	res, err := c.send(ctx, method, path, form)
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	return res.StatusCode, nil
+}
+
+// send sends a request to the Django admin.
+// forms are submitted together with the csrf token from the cookie jar of the client.
+func (c *djangoAdminClient) send(ctx context.Context, method string, path string, form url.Values) (*http.Response, error) {
+	target := c.host + path
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.NewReader("")
+	if form != nil {
+		for _, cookie := range c.client.Jar.Cookies(u) {
+			if cookie.Name == "csrftoken" {
+				form.Set("csrfmiddlewaretoken", cookie.Value)
+			}
+		}
+
+		body = strings.NewReader(form.Encode())
+	}
+
+	r, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range c.headers {
+		r.Header.Set(name, value)
+	}
+
+	if form != nil {
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	// django verifies the referer for requests over https
+	r.Header.Set("Referer", target)
+	r.Header.Set("User-Agent", c.userAgent)
+
+	return c.client.Do(r)
+}
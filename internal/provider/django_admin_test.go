@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDjangoAdmin returns a server imitating the login of the Django admin.
+// tests register the forms they need on the returned mux.
+func newTestDjangoAdmin(t *testing.T, passwords map[string]string) (*httptest.Server, *http.ServeMux) {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/admin/login/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: "login-token", Path: "/"})
+			return
+		}
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "login-token", r.PostForm.Get("csrfmiddlewaretoken"))
+
+		if passwords[r.PostForm.Get("username")] != r.PostForm.Get("password") {
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: "session-token", Path: "/"})
+		http.SetCookie(w, &http.Cookie{Name: "sessionid", Value: "session", Path: "/"})
+		http.Redirect(w, r, "/admin/", http.StatusFound)
+	})
+
+	return httptest.NewServer(mux), mux
+}
+
+// requireTestDjangoAdminSession verifies a form was submitted within a session of the test server.
+func requireTestDjangoAdminSession(t *testing.T, r *http.Request) {
+	require.NoError(t, r.ParseForm())
+	require.Equal(t, "session-token", r.PostForm.Get("csrfmiddlewaretoken"))
+
+	_, err := r.Cookie("sessionid")
+	require.NoError(t, err)
+}
+
+func TestUnitNewDjangoAdminClient(t *testing.T) {
+	server, _ := newTestDjangoAdmin(t, map[string]string{"admin": "adminPassword"})
+	defer server.Close()
+
+	providerData := &DefectdojoProviderData{
+		HTTPClient: server.Client(),
+		Host:       server.URL,
+		Username:   "admin",
+		Password:   "adminPassword",
+	}
+
+	_, err := newDjangoAdminClient(context.Background(), providerData)
+
+	require.NoError(t, err)
+}
+
+func TestUnitNewDjangoAdminClientInvalidLogin(t *testing.T) {
+	server, _ := newTestDjangoAdmin(t, map[string]string{"admin": "adminPassword"})
+	defer server.Close()
+
+	providerData := &DefectdojoProviderData{
+		HTTPClient: server.Client(),
+		Host:       server.URL,
+		Username:   "admin",
+		Password:   "wrongPassword",
+	}
+
+	_, err := newDjangoAdminClient(context.Background(), providerData)
+
+	require.Error(t, err)
+}
+
+func TestUnitNewDjangoAdminClientWithoutCredentials(t *testing.T) {
+	providerData := &DefectdojoProviderData{
+		Host: "http://localhost",
+	}
+
+	_, err := newDjangoAdminClient(context.Background(), providerData)
+
+	require.Error(t, err)
+}
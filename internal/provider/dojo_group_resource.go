@@ -88,17 +88,17 @@ func (r *dojoGroupResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*defectdojo.APIClient)
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *defectdojo.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -121,9 +121,9 @@ func (r *dojoGroupResource) Create(ctx context.Context, req resource.CreateReque
 	// Generate request from plan
 	dojoGroupRequest := defectdojo.DojoGroupRequest{
 		Name:                     plan.Name.ValueString(),
-		Description:              *defectdojo.NewNullableString(plan.Description.ValueStringPointer()),
+		Description:              basetypesStringValueToDefectdojoNullableString(plan.Description),
 		ConfigurationPermissions: configurations,
-		SocialProvider:           *defectdojo.NewNullableString(plan.SocialProvider.ValueStringPointer()),
+		SocialProvider:           basetypesStringValueToDefectdojoNullableString(plan.SocialProvider),
 	}
 
 	// Create new dojo group
@@ -139,8 +139,8 @@ func (r *dojoGroupResource) Create(ctx context.Context, req resource.CreateReque
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(dojoGroup.GetId()))
 	plan.Name = types.StringValue(dojoGroup.GetName())
-	plan.Description = types.StringValue(dojoGroup.GetDescription())
-	plan.SocialProvider = types.StringValue(dojoGroup.GetSocialProvider())
+	plan.Description = defectdojoNullableStringToBasetypesStringValue(dojoGroup.Description)
+	plan.SocialProvider = defectdojoNullableStringToBasetypesStringValue(dojoGroup.SocialProvider)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -179,8 +179,8 @@ func (r *dojoGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Overwrite state with refreshed state
 	state.ID = types.Int64Value(int64(dojoGroup.GetId()))
 	state.Name = types.StringValue(dojoGroup.GetName())
-	state.Description = types.StringValue(dojoGroup.GetDescription())
-	state.SocialProvider = types.StringValue(dojoGroup.GetSocialProvider())
+	state.Description = defectdojoNullableStringToBasetypesStringValue(dojoGroup.Description)
+	state.SocialProvider = defectdojoNullableStringToBasetypesStringValue(dojoGroup.SocialProvider)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -216,9 +216,9 @@ func (r *dojoGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	// Generate request from plan
 	dojoGroupRequest := defectdojo.DojoGroupRequest{
 		Name:                     plan.Name.ValueString(),
-		Description:              *defectdojo.NewNullableString(plan.Description.ValueStringPointer()),
+		Description:              basetypesStringValueToDefectdojoNullableString(plan.Description),
 		ConfigurationPermissions: configurations,
-		SocialProvider:           *defectdojo.NewNullableString(plan.SocialProvider.ValueStringPointer()),
+		SocialProvider:           basetypesStringValueToDefectdojoNullableString(plan.SocialProvider),
 	}
 
 	// Update existing dojo group
@@ -244,8 +244,8 @@ func (r *dojoGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(dojoGroup.GetId()))
 	plan.Name = types.StringValue(dojoGroup.GetName())
-	plan.Description = types.StringValue(dojoGroup.GetDescription())
-	plan.SocialProvider = types.StringValue(dojoGroup.GetSocialProvider())
+	plan.Description = defectdojoNullableStringToBasetypesStringValue(dojoGroup.Description)
+	plan.SocialProvider = defectdojoNullableStringToBasetypesStringValue(dojoGroup.SocialProvider)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -0,0 +1,422 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &endpointResource{}
+	_ resource.ResourceWithConfigure   = &endpointResource{}
+	_ resource.ResourceWithImportState = &endpointResource{}
+)
+
+// NewEndpointResource is a helper function to simplify the provider implementation.
+func NewEndpointResource() resource.Resource {
+	return &endpointResource{}
+}
+
+// endpointResource is the resource implementation.
+type endpointResource struct {
+	client *defectdojo.APIClient
+}
+
+type endpointResourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Product  types.Int64  `tfsdk:"product"`
+	URL      types.String `tfsdk:"url"`
+	Protocol types.String `tfsdk:"protocol"`
+	Userinfo types.String `tfsdk:"userinfo"`
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Path     types.String `tfsdk:"path"`
+	Query    types.String `tfsdk:"query"`
+	Fragment types.String `tfsdk:"fragment"`
+	Tags     types.List   `tfsdk:"tags"`
+}
+
+// Metadata returns the resource type name.
+func (r *endpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint"
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// the url and the components are two ways to configure the same endpoint
+	componentPaths := []path.Expression{
+		path.MatchRoot("protocol"),
+		path.MatchRoot("userinfo"),
+		path.MatchRoot("host"),
+		path.MatchRoot("port"),
+		path.MatchRoot("path"),
+		path.MatchRoot("query"),
+		path.MatchRoot("fragment"),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the endpoint",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"product": schema.Int64Attribute{
+				Description: "The product ID of the endpoint",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The full URL of the endpoint, e.g. https://example.com/path. It is split into the other attributes, which can't be set together with it. URLs that only differ in a default port or a trailing slash after the host are treated as equal",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					endpointURLValidator{},
+					stringvalidator.ConflictsWith(componentPaths...),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("host")),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description: "The communication protocol or scheme, e.g. http, ftp or dns",
+				Computed:    true,
+				Optional:    true,
+			},
+			"userinfo": schema.StringAttribute{
+				Description: "The user info, e.g. alice",
+				Computed:    true,
+				Optional:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "The host name or IP address without the port",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "The network port of the endpoint",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "The location of the resource without the leading /, e.g. endpoint/420/edit",
+				Computed:    true,
+				Optional:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "The query string without the leading ?",
+				Computed:    true,
+				Optional:    true,
+			},
+			"fragment": schema.StringAttribute{
+				Description: "The fragment identifier without the leading #",
+				Computed:    true,
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of tags for the endpoint",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *endpointResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *endpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan endpointResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := splitEndpointURL(&plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid Endpoint URL", err.Error())
+		return
+	}
+
+	// Generate request from plan
+	endpointRequest := endpointRequestFromPlan(plan, tags)
+
+	// Create new endpoint
+	endpoint, res, err := r.client.EndpointsAPI.EndpointsCreate(ctx).EndpointRequest(endpointRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Endpoint",
+			"Could not create endpoint, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(endpoint.GetId()))
+	mapEndpointToModel(endpoint, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), endpoint.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *endpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state endpointResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed endpoint value from Defectdojo
+	endpoint, res, err := r.client.EndpointsAPI.EndpointsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Endpoint",
+			"Could not read endpoint with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(endpoint.GetId()))
+	mapEndpointToModel(endpoint, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), endpoint.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *endpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan endpointResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := splitEndpointURL(&plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid Endpoint URL", err.Error())
+		return
+	}
+
+	// Generate request from plan
+	endpointRequest := endpointRequestFromPlan(plan, tags)
+
+	// Update existing endpoint
+	_, res, err := r.client.EndpointsAPI.EndpointsUpdate(ctx, int32(plan.ID.ValueInt64())).EndpointRequest(endpointRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Endpoint",
+			"Could not update endpoint with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed endpoint value from Defectdojo
+	endpoint, res, err := r.client.EndpointsAPI.EndpointsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Endpoint",
+			"Could not read endpoint with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(endpoint.GetId()))
+	mapEndpointToModel(endpoint, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), endpoint.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *endpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state endpointResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing endpoint
+	res, err := r.client.EndpointsAPI.EndpointsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Endpoint",
+			"Could not delete endpoint, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *endpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// splitEndpointURL replaces the components of the plan with the ones of the configured url.
+// if the url is not configured, the components are used as they are.
+func splitEndpointURL(plan *endpointResourceModel) error {
+	if plan.URL.IsNull() || plan.URL.IsUnknown() {
+		return nil
+	}
+
+	components, err := parseEndpointURL(plan.URL.ValueString())
+	if err != nil {
+		return err
+	}
+
+	plan.Protocol = emptyStringToBasetypesStringNull(components.Protocol)
+	plan.Userinfo = emptyStringToBasetypesStringNull(components.Userinfo)
+	plan.Host = emptyStringToBasetypesStringNull(components.Host)
+	plan.Path = emptyStringToBasetypesStringNull(components.Path)
+	plan.Query = emptyStringToBasetypesStringNull(components.Query)
+	plan.Fragment = emptyStringToBasetypesStringNull(components.Fragment)
+	plan.Port = types.Int64Null()
+	if components.Port != 0 {
+		plan.Port = types.Int64Value(int64(components.Port))
+	}
+
+	return nil
+}
+
+// endpointRequestFromPlan generates the request for creating and updating an endpoint.
+func endpointRequestFromPlan(plan endpointResourceModel, tags []string) defectdojo.EndpointRequest {
+	return defectdojo.EndpointRequest{
+		Protocol: basetypesStringValueToDefectdojoNullableString(plan.Protocol),
+		Userinfo: basetypesStringValueToDefectdojoNullableString(plan.Userinfo),
+		Host:     basetypesStringValueToDefectdojoNullableString(plan.Host),
+		Port:     basetypesInt64ValueToDefectdojoNullableInt32(plan.Port),
+		Path:     basetypesStringValueToDefectdojoNullableString(plan.Path),
+		Query:    basetypesStringValueToDefectdojoNullableString(plan.Query),
+		Fragment: basetypesStringValueToDefectdojoNullableString(plan.Fragment),
+		Product:  basetypesInt64ValueToDefectdojoNullableInt32(plan.Product),
+		Tags:     tags,
+	}
+}
+
+// mapEndpointToModel maps the components of an endpoint to the model.
+// the url is only replaced if it does not describe the same endpoint anymore,
+// so a configured url like https://example.com:443/ does not cause a diff.
+func mapEndpointToModel(endpoint *defectdojo.Endpoint, model *endpointResourceModel) {
+	model.Product = defectdojoNullableInt32ToBasetypesInt64Value(endpoint.Product)
+	model.Protocol = defectdojoNullableStringToBasetypesStringValue(endpoint.Protocol)
+	model.Userinfo = defectdojoNullableStringToBasetypesStringValue(endpoint.Userinfo)
+	model.Host = defectdojoNullableStringToBasetypesStringValue(endpoint.Host)
+	model.Port = defectdojoNullableInt32ToBasetypesInt64Value(endpoint.Port)
+	model.Path = defectdojoNullableStringToBasetypesStringValue(endpoint.Path)
+	model.Query = defectdojoNullableStringToBasetypesStringValue(endpoint.Query)
+	model.Fragment = defectdojoNullableStringToBasetypesStringValue(endpoint.Fragment)
+
+	components := endpointComponents{
+		Protocol: endpoint.GetProtocol(),
+		Userinfo: endpoint.GetUserinfo(),
+		Host:     endpoint.GetHost(),
+		Port:     endpoint.GetPort(),
+		Path:     endpoint.GetPath(),
+		Query:    endpoint.GetQuery(),
+		Fragment: endpoint.GetFragment(),
+	}
+
+	if model.URL.IsNull() || model.URL.IsUnknown() || !sameEndpointURL(model.URL.ValueString(), components.String()) {
+		model.URL = types.StringValue(components.String())
+	}
+}
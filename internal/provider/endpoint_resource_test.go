@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_product_type" "test_product_type" {
+					name        = "Test Product Type"
+					description = "This is the description of the Test Product Type"
+				}
+
+				resource "defectdojo_product" "test_product" {
+					name        = "Test Product"
+					description = "This is the description of the Test Product"
+					prod_type   = defectdojo_product_type.test_product_type.id
+				}
+
+				resource "defectdojo_endpoint" "test" {
+					product = defectdojo_product.test_product.id
+					url     = "https://a.example.com:443/"
+					tags    = ["public"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "url", "https://a.example.com:443/"),
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "protocol", "https"),
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "host", "a.example.com"),
+					resource.TestCheckNoResourceAttr("defectdojo_endpoint.test", "port"),
+					resource.TestCheckNoResourceAttr("defectdojo_endpoint.test", "path"),
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("defectdojo_endpoint.test", "product", "defectdojo_product.test_product", "id"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_endpoint.test", "id"),
+				),
+			},
+			// Normalized URL does not cause a diff
+			{
+				Config: providerConfig + `
+				resource "defectdojo_product_type" "test_product_type" {
+					name        = "Test Product Type"
+					description = "This is the description of the Test Product Type"
+				}
+
+				resource "defectdojo_product" "test_product" {
+					name        = "Test Product"
+					description = "This is the description of the Test Product"
+					prod_type   = defectdojo_product_type.test_product_type.id
+				}
+
+				resource "defectdojo_endpoint" "test" {
+					product = defectdojo_product.test_product.id
+					url     = "https://a.example.com"
+					tags    = ["public"]
+				}
+			`,
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_endpoint.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_product_type" "test_product_type" {
+					name        = "Test Product Type"
+					description = "This is the description of the Test Product Type"
+				}
+
+				resource "defectdojo_product" "test_product" {
+					name        = "Test Product"
+					description = "This is the description of the Test Product"
+					prod_type   = defectdojo_product_type.test_product_type.id
+				}
+
+				resource "defectdojo_endpoint" "test" {
+					product  = defectdojo_product.test_product.id
+					protocol = "https"
+					host     = "a.example.com"
+					port     = 8443
+					path     = "api/v1"
+					tags     = ["public"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "url", "https://a.example.com:8443/api/v1"),
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "port", "8443"),
+					resource.TestCheckResourceAttr("defectdojo_endpoint.test", "path", "api/v1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// endpointDefaultPorts are dropped when normalizing an endpoint url,
+// so https://example.com and https://example.com:443 describe the same endpoint.
+var endpointDefaultPorts = map[string]int32{
+	"ftp":   21,
+	"ssh":   22,
+	"http":  80,
+	"https": 443,
+}
+
+// endpointComponents are the parts of an endpoint url the way Defectdojo stores them.
+// path, query and fragment are stored without their leading /, ? and #.
+type endpointComponents struct {
+	Protocol string
+	Userinfo string
+	Host     string
+	Port     int32
+	Path     string
+	Query    string
+	Fragment string
+}
+
+// parseEndpointURL splits an endpoint url into its components and normalizes them.
+// the protocol is optional, Defectdojo also knows endpoints that are just a host.
+func parseEndpointURL(raw string) (endpointComponents, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "//" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return endpointComponents{}, err
+	}
+
+	if u.Hostname() == "" {
+		return endpointComponents{}, fmt.Errorf("the endpoint url %q is missing a host", raw)
+	}
+
+	c := endpointComponents{
+		Protocol: strings.ToLower(u.Scheme),
+		Host:     strings.ToLower(u.Hostname()),
+		Path:     strings.TrimPrefix(u.EscapedPath(), "/"),
+		Query:    u.RawQuery,
+		Fragment: u.EscapedFragment(),
+	}
+
+	if u.User != nil {
+		c.Userinfo = u.User.String()
+	}
+
+	if u.Port() != "" {
+		port, err := strconv.ParseInt(u.Port(), 10, 32)
+		if err != nil || port < 1 || port > 65535 {
+			return endpointComponents{}, fmt.Errorf("the endpoint url %q has an invalid port", raw)
+		}
+
+		c.Port = int32(port)
+	}
+
+	if c.Port == endpointDefaultPorts[c.Protocol] {
+		c.Port = 0
+	}
+
+	return c, nil
+}
+
+// String joins the components to the normalized url of the endpoint.
+func (c endpointComponents) String() string {
+	var b strings.Builder
+
+	if c.Protocol != "" {
+		b.WriteString(c.Protocol + "://")
+	}
+
+	if c.Userinfo != "" {
+		b.WriteString(c.Userinfo + "@")
+	}
+
+	if c.Port != 0 {
+		b.WriteString(net.JoinHostPort(c.Host, strconv.Itoa(int(c.Port))))
+	} else if strings.Contains(c.Host, ":") {
+		b.WriteString("[" + c.Host + "]")
+	} else {
+		b.WriteString(c.Host)
+	}
+
+	if c.Path != "" {
+		b.WriteString("/" + c.Path)
+	}
+
+	if c.Query != "" {
+		b.WriteString("?" + c.Query)
+	}
+
+	if c.Fragment != "" {
+		b.WriteString("#" + c.Fragment)
+	}
+
+	return b.String()
+}
+
+// sameEndpointURL reports whether two endpoint urls are equal after normalization.
+func sameEndpointURL(a string, b string) bool {
+	ca, err := parseEndpointURL(a)
+	if err != nil {
+		return false
+	}
+
+	cb, err := parseEndpointURL(b)
+	if err != nil {
+		return false
+	}
+
+	return ca == cb
+}
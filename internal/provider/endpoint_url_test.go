@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitParseEndpointURL(t *testing.T) {
+	c, err := parseEndpointURL("HTTPS://alice@API.example.com:8443/v1/users/?page=2#top")
+	require.NoError(t, err)
+	require.Equal(t, endpointComponents{
+		Protocol: "https",
+		Userinfo: "alice",
+		Host:     "api.example.com",
+		Port:     8443,
+		Path:     "v1/users/",
+		Query:    "page=2",
+		Fragment: "top",
+	}, c)
+	require.Equal(t, "https://alice@api.example.com:8443/v1/users/?page=2#top", c.String())
+
+	c, err = parseEndpointURL("https://a.example.com:443/")
+	require.NoError(t, err)
+	require.Equal(t, endpointComponents{Protocol: "https", Host: "a.example.com"}, c)
+	require.Equal(t, "https://a.example.com", c.String())
+
+	c, err = parseEndpointURL("10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, endpointComponents{Host: "10.0.0.1"}, c)
+	require.Equal(t, "10.0.0.1", c.String())
+
+	c, err = parseEndpointURL("http://[::1]:8080")
+	require.NoError(t, err)
+	require.Equal(t, "::1", c.Host)
+	require.Equal(t, "http://[::1]:8080", c.String())
+
+	_, err = parseEndpointURL("https://")
+	require.Error(t, err)
+
+	_, err = parseEndpointURL("https://a.example.com:99999")
+	require.Error(t, err)
+}
+
+func TestUnitSameEndpointURL(t *testing.T) {
+	require.True(t, sameEndpointURL("https://a.example.com", "https://a.example.com:443/"))
+	require.True(t, sameEndpointURL("http://A.example.com:80", "http://a.example.com"))
+	require.False(t, sameEndpointURL("http://a.example.com", "https://a.example.com"))
+	require.False(t, sameEndpointURL("https://a.example.com/a", "https://a.example.com/a/"))
+	require.False(t, sameEndpointURL("https://", "https://"))
+}
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &engagementPresetResource{}
+	_ resource.ResourceWithConfigure   = &engagementPresetResource{}
+	_ resource.ResourceWithImportState = &engagementPresetResource{}
+)
+
+// NewEngagementPresetResource is a helper function to simplify the provider implementation.
+func NewEngagementPresetResource() resource.Resource {
+	return &engagementPresetResource{}
+}
+
+// engagementPresetResource is the resource implementation.
+type engagementPresetResource struct {
+	client *defectdojo.APIClient
+}
+
+type engagementPresetResourceModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Title            types.String `tfsdk:"title"`
+	Notes            types.String `tfsdk:"notes"`
+	Scope            types.String `tfsdk:"scope"`
+	Product          types.Int64  `tfsdk:"product"`
+	TestType         types.List   `tfsdk:"test_type"`
+	NetworkLocations types.List   `tfsdk:"network_locations"`
+}
+
+// Metadata returns the resource type name.
+func (r *engagementPresetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engagement_preset"
+}
+
+// Schema defines the schema for the resource.
+func (r *engagementPresetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the engagement preset",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the engagement preset, e.g. Annual Pentest",
+				Required:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "Notes for engagements created from the preset",
+				Computed:    true,
+				Optional:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "The scope of engagements created from the preset. Defectdojo treats an empty scope as no scope, so it can't be set to an empty string",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"product": schema.Int64Attribute{
+				Description: "The ID of the product the engagement preset belongs to",
+				Required:    true,
+			},
+			"test_type": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Description: "List of test type IDs of engagements created from the preset",
+				Computed:    true,
+				Optional:    true,
+			},
+			"network_locations": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Description: "List of network location IDs of engagements created from the preset",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *engagementPresetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *engagementPresetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan engagementPresetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	engagementPresetRequest, diags := engagementPresetRequestFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new engagement preset
+	engagementPreset, res, err := r.client.EngagementPresetsAPI.EngagementPresetsCreate(ctx).EngagementPresetsRequest(engagementPresetRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Engagement Preset",
+			"Could not create engagement preset, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(engagementPreset.GetId()))
+	resp.Diagnostics.Append(mapEngagementPresetToModel(ctx, engagementPreset, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *engagementPresetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state engagementPresetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed engagement preset value from Defectdojo
+	engagementPreset, res, err := r.client.EngagementPresetsAPI.EngagementPresetsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Engagement Preset",
+			"Could not read engagement preset with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(engagementPreset.GetId()))
+	resp.Diagnostics.Append(mapEngagementPresetToModel(ctx, engagementPreset, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *engagementPresetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan engagementPresetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	engagementPresetRequest, diags := engagementPresetRequestFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing engagement preset
+	_, res, err := r.client.EngagementPresetsAPI.EngagementPresetsUpdate(ctx, int32(plan.ID.ValueInt64())).EngagementPresetsRequest(engagementPresetRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Engagement Preset",
+			"Could not update engagement preset with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed engagement preset value from Defectdojo
+	engagementPreset, res, err := r.client.EngagementPresetsAPI.EngagementPresetsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Engagement Preset",
+			"Could not read engagement preset with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(engagementPreset.GetId()))
+	resp.Diagnostics.Append(mapEngagementPresetToModel(ctx, engagementPreset, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *engagementPresetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state engagementPresetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing engagement preset
+	res, err := r.client.EngagementPresetsAPI.EngagementPresetsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Engagement Preset",
+			"Could not delete engagement preset, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *engagementPresetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// engagementPresetRequestFromPlan generates the request for creating and updating an engagement preset.
+func engagementPresetRequestFromPlan(ctx context.Context, plan engagementPresetResourceModel) (defectdojo.EngagementPresetsRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	testTypes := make([]int32, 0)
+	diags.Append(plan.TestType.ElementsAs(ctx, &testTypes, true)...)
+
+	networkLocations := make([]int32, 0)
+	diags.Append(plan.NetworkLocations.ElementsAs(ctx, &networkLocations, true)...)
+
+	return defectdojo.EngagementPresetsRequest{
+		Title:            plan.Title.ValueString(),
+		Notes:            basetypesStringValueToDefectdojoNullableString(plan.Notes),
+		Scope:            basetypesStringValueToStringPointer(plan.Scope),
+		Product:          int32(plan.Product.ValueInt64()),
+		TestType:         testTypes,
+		NetworkLocations: networkLocations,
+	}, diags
+}
+
+// mapEngagementPresetToModel maps an engagement preset to the model.
+func mapEngagementPresetToModel(ctx context.Context, engagementPreset *defectdojo.EngagementPresets, model *engagementPresetResourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.Title = types.StringValue(engagementPreset.GetTitle())
+	model.Notes = defectdojoNullableStringToBasetypesStringValue(engagementPreset.Notes)
+	model.Scope = emptyStringToBasetypesStringNull(engagementPreset.GetScope())
+	model.Product = types.Int64Value(int64(engagementPreset.GetProduct()))
+
+	model.TestType, d = types.ListValueFrom(ctx, types.Int64Type, engagementPreset.TestType)
+	diags.Append(d...)
+
+	model.NetworkLocations, d = types.ListValueFrom(ctx, types.Int64Type, engagementPreset.NetworkLocations)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccEngagementPresetDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_test_type" "test_test_type" {
+	name = "Test Engagement Preset Test Type"
+}
+`
+
+func TestAccEngagementPresetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccEngagementPresetDependencies + `
+				resource "defectdojo_engagement_preset" "test" {
+					title   = "Test Engagement Preset"
+					scope   = "All public endpoints"
+					product = defectdojo_product.test_product.id
+				}
+
+				resource "defectdojo_engagement" "test_engagement" {
+					name         = "Test Engagement"
+					product      = defectdojo_product.test_product.id
+					target_start = "2024-01-01"
+					target_end   = "2024-01-31"
+					preset       = defectdojo_engagement_preset.test.id
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_engagement_preset.test", "title", "Test Engagement Preset"),
+					resource.TestCheckResourceAttr("defectdojo_engagement_preset.test", "scope", "All public endpoints"),
+					resource.TestCheckResourceAttrPair("defectdojo_engagement_preset.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_engagement.test_engagement", "preset", "defectdojo_engagement_preset.test", "id"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_engagement_preset.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_engagement_preset.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccEngagementPresetDependencies + `
+				resource "defectdojo_engagement_preset" "test" {
+					title     = "Test Engagement Preset"
+					notes     = "Coordinate the test window with the operations team"
+					scope     = "All public endpoints"
+					product   = defectdojo_product.test_product.id
+					test_type = [defectdojo_test_type.test_test_type.id]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_engagement_preset.test", "notes", "Coordinate the test window with the operations team"),
+					resource.TestCheckResourceAttr("defectdojo_engagement_preset.test", "test_type.#", "1"),
+					resource.TestCheckResourceAttrPair("defectdojo_engagement_preset.test", "test_type.0", "defectdojo_test_type.test_test_type", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEngagementPresetResourceEmptyScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "defectdojo_engagement_preset" "test" {
+					title   = "Test Engagement Preset"
+					scope   = ""
+					product = 1
+				}
+			`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Length"),
+			},
+		},
+	})
+}
@@ -200,7 +200,7 @@ func (r *engagementResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 			},
 			"preset": schema.Int64Attribute{
-				Description: "Settings and notes for performing this engagement",
+				Description: "The ID of the engagement preset with settings and notes for performing this engagement",
 				Computed:    true,
 				Optional:    true,
 			},
@@ -214,17 +214,17 @@ func (r *engagementResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"build_server": schema.Int64Attribute{
-				Description: "Build server responsible for CI/CD test",
+				Description: "The ID of the tool configuration of the build server responsible for CI/CD test",
 				Computed:    true,
 				Optional:    true,
 			},
 			"source_code_management_server": schema.Int64Attribute{
-				Description: "Source code server for CI/CD test",
+				Description: "The ID of the tool configuration of the source code server for CI/CD test",
 				Computed:    true,
 				Optional:    true,
 			},
 			"orchestration_engine": schema.Int64Attribute{
-				Description: "Orchestration engine responsible for CI/CD test",
+				Description: "The ID of the tool configuration of the orchestration engine responsible for CI/CD test",
 				Computed:    true,
 				Optional:    true,
 			},
@@ -244,17 +244,17 @@ func (r *engagementResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*defectdojo.APIClient)
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *defectdojo.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -276,26 +276,26 @@ func (r *engagementResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Generate request from plan
 	engagementRequest := defectdojo.EngagementRequest{
-		Name:                       *defectdojo.NewNullableString(plan.Name.ValueStringPointer()),
-		Description:                *defectdojo.NewNullableString(plan.Description.ValueStringPointer()),
-		Version:                    *defectdojo.NewNullableString(plan.Version.ValueStringPointer()),
+		Name:                       basetypesStringValueToDefectdojoNullableString(plan.Name),
+		Description:                basetypesStringValueToDefectdojoNullableString(plan.Description),
+		Version:                    basetypesStringValueToDefectdojoNullableString(plan.Version),
 		FirstContacted:             basetypesStringValueToDefectdojoNullableString(plan.FirstContacted),
 		TargetStart:                plan.TargetStart.ValueString(),
 		TargetEnd:                  plan.TargetEnd.ValueString(),
-		Reason:                     *defectdojo.NewNullableString(plan.Reason.ValueStringPointer()),
-		Tracker:                    *defectdojo.NewNullableString(plan.Tracker.ValueStringPointer()),
-		TestStrategy:               *defectdojo.NewNullableString(plan.TestStrategy.ValueStringPointer()),
-		ThreatModel:                plan.ThreatModel.ValueBoolPointer(),
-		ApiTest:                    plan.APITest.ValueBoolPointer(),
-		PenTest:                    plan.PenTest.ValueBoolPointer(),
-		CheckList:                  plan.CheckList.ValueBoolPointer(),
+		Reason:                     basetypesStringValueToDefectdojoNullableString(plan.Reason),
+		Tracker:                    basetypesStringValueToDefectdojoNullableString(plan.Tracker),
+		TestStrategy:               basetypesStringValueToDefectdojoNullableString(plan.TestStrategy),
+		ThreatModel:                basetypesBoolValueToBoolPointer(plan.ThreatModel),
+		ApiTest:                    basetypesBoolValueToBoolPointer(plan.APITest),
+		PenTest:                    basetypesBoolValueToBoolPointer(plan.PenTest),
+		CheckList:                  basetypesBoolValueToBoolPointer(plan.CheckList),
 		Status:                     basetypesStringValueToDefectdojoNullableString(plan.Status),
 		EngagementType:             basetypesStringValueToDefectdojoNullableString(plan.EngagementType),
-		BuildId:                    *defectdojo.NewNullableString(plan.BuildID.ValueStringPointer()),
-		CommitHash:                 *defectdojo.NewNullableString(plan.CommitHash.ValueStringPointer()),
-		BranchTag:                  *defectdojo.NewNullableString(plan.BranchTag.ValueStringPointer()),
-		SourceCodeManagementUri:    *defectdojo.NewNullableString(plan.SourceCodeManagementURI.ValueStringPointer()),
-		DeduplicationOnEngagement:  plan.DeduplicationOnEngagement.ValueBoolPointer(),
+		BuildId:                    basetypesStringValueToDefectdojoNullableString(plan.BuildID),
+		CommitHash:                 basetypesStringValueToDefectdojoNullableString(plan.CommitHash),
+		BranchTag:                  basetypesStringValueToDefectdojoNullableString(plan.BranchTag),
+		SourceCodeManagementUri:    basetypesStringValueToDefectdojoNullableString(plan.SourceCodeManagementURI),
+		DeduplicationOnEngagement:  basetypesBoolValueToBoolPointer(plan.DeduplicationOnEngagement),
 		Lead:                       basetypesInt64ValueToDefectdojoNullableInt32(plan.Lead),
 		Requester:                  basetypesInt64ValueToDefectdojoNullableInt32(plan.Requester),
 		Preset:                     basetypesInt64ValueToDefectdojoNullableInt32(plan.Preset),
@@ -319,34 +319,34 @@ func (r *engagementResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(engagement.GetId()))
-	plan.Name = types.StringValue(engagement.GetName())
-	plan.Description = types.StringValue(engagement.GetDescription())
-	plan.Version = types.StringValue(engagement.GetVersion())
-	plan.FirstContacted = types.StringValue(engagement.GetFirstContacted())
+	plan.Name = defectdojoNullableStringToBasetypesStringValue(engagement.Name)
+	plan.Description = defectdojoNullableStringToBasetypesStringValue(engagement.Description)
+	plan.Version = defectdojoNullableStringToBasetypesStringValue(engagement.Version)
+	plan.FirstContacted = defectdojoNullableStringToBasetypesStringValue(engagement.FirstContacted)
 	plan.TargetStart = types.StringValue(engagement.GetTargetStart())
 	plan.TargetEnd = types.StringValue(engagement.GetTargetEnd())
-	plan.Reason = types.StringValue(engagement.GetReason())
-	plan.Tracker = types.StringValue(engagement.GetTracker())
-	plan.TestStrategy = types.StringValue(engagement.GetTestStrategy())
-	plan.ThreatModel = types.BoolValue(engagement.GetThreatModel())
-	plan.APITest = types.BoolValue(engagement.GetApiTest())
-	plan.PenTest = types.BoolValue(engagement.GetPenTest())
-	plan.CheckList = types.BoolValue(engagement.GetCheckList())
-	plan.Status = types.StringValue(engagement.GetStatus())
-	plan.EngagementType = types.StringValue(engagement.GetEngagementType())
-	plan.BuildID = types.StringValue(engagement.GetBuildId())
-	plan.CommitHash = types.StringValue(engagement.GetCommitHash())
-	plan.BranchTag = types.StringValue(engagement.GetBranchTag())
-	plan.SourceCodeManagementURI = types.StringValue(engagement.GetSourceCodeManagementUri())
-	plan.DeduplicationOnEngagement = types.BoolValue(engagement.GetDeduplicationOnEngagement())
-	plan.Lead = types.Int64Value(int64(engagement.GetLead()))
-	plan.Requester = types.Int64Value(int64(engagement.GetRequester()))
-	plan.Preset = types.Int64Value(int64(engagement.GetPreset()))
-	plan.ReportType = types.Int64Value(int64(engagement.GetReportType()))
+	plan.Reason = defectdojoNullableStringToBasetypesStringValue(engagement.Reason)
+	plan.Tracker = defectdojoNullableStringToBasetypesStringValue(engagement.Tracker)
+	plan.TestStrategy = defectdojoNullableStringToBasetypesStringValue(engagement.TestStrategy)
+	plan.ThreatModel = boolPointerToBasetypesBoolValue(engagement.ThreatModel)
+	plan.APITest = boolPointerToBasetypesBoolValue(engagement.ApiTest)
+	plan.PenTest = boolPointerToBasetypesBoolValue(engagement.PenTest)
+	plan.CheckList = boolPointerToBasetypesBoolValue(engagement.CheckList)
+	plan.Status = defectdojoNullableStringToBasetypesStringValue(engagement.Status)
+	plan.EngagementType = defectdojoNullableStringToBasetypesStringValue(engagement.EngagementType)
+	plan.BuildID = defectdojoNullableStringToBasetypesStringValue(engagement.BuildId)
+	plan.CommitHash = defectdojoNullableStringToBasetypesStringValue(engagement.CommitHash)
+	plan.BranchTag = defectdojoNullableStringToBasetypesStringValue(engagement.BranchTag)
+	plan.SourceCodeManagementURI = defectdojoNullableStringToBasetypesStringValue(engagement.SourceCodeManagementUri)
+	plan.DeduplicationOnEngagement = boolPointerToBasetypesBoolValue(engagement.DeduplicationOnEngagement)
+	plan.Lead = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Lead)
+	plan.Requester = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Requester)
+	plan.Preset = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Preset)
+	plan.ReportType = defectdojoNullableInt32ToBasetypesInt64Value(engagement.ReportType)
 	plan.Product = types.Int64Value(int64(engagement.GetProduct()))
-	plan.BuildServer = types.Int64Value(int64(engagement.GetBuildServer()))
-	plan.SourceCodeManagementServer = types.Int64Value(int64(engagement.GetSourceCodeManagementServer()))
-	plan.OrchestrationEngine = types.Int64Value(int64(engagement.GetOrchestrationEngine()))
+	plan.BuildServer = defectdojoNullableInt32ToBasetypesInt64Value(engagement.BuildServer)
+	plan.SourceCodeManagementServer = defectdojoNullableInt32ToBasetypesInt64Value(engagement.SourceCodeManagementServer)
+	plan.OrchestrationEngine = defectdojoNullableInt32ToBasetypesInt64Value(engagement.OrchestrationEngine)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -384,34 +384,34 @@ func (r *engagementResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Overwrite state with refreshed state
 	state.ID = types.Int64Value(int64(engagement.GetId()))
-	state.Name = types.StringValue(engagement.GetName())
-	state.Description = types.StringValue(engagement.GetDescription())
-	state.Version = types.StringValue(engagement.GetVersion())
-	state.FirstContacted = types.StringValue(engagement.GetFirstContacted())
+	state.Name = defectdojoNullableStringToBasetypesStringValue(engagement.Name)
+	state.Description = defectdojoNullableStringToBasetypesStringValue(engagement.Description)
+	state.Version = defectdojoNullableStringToBasetypesStringValue(engagement.Version)
+	state.FirstContacted = defectdojoNullableStringToBasetypesStringValue(engagement.FirstContacted)
 	state.TargetStart = types.StringValue(engagement.GetTargetStart())
 	state.TargetEnd = types.StringValue(engagement.GetTargetEnd())
-	state.Reason = types.StringValue(engagement.GetReason())
-	state.Tracker = types.StringValue(engagement.GetTracker())
-	state.TestStrategy = types.StringValue(engagement.GetTestStrategy())
-	state.ThreatModel = types.BoolValue(engagement.GetThreatModel())
-	state.APITest = types.BoolValue(engagement.GetApiTest())
-	state.PenTest = types.BoolValue(engagement.GetPenTest())
-	state.CheckList = types.BoolValue(engagement.GetCheckList())
-	state.Status = types.StringValue(engagement.GetStatus())
-	state.EngagementType = types.StringValue(engagement.GetEngagementType())
-	state.BuildID = types.StringValue(engagement.GetBuildId())
-	state.CommitHash = types.StringValue(engagement.GetCommitHash())
-	state.BranchTag = types.StringValue(engagement.GetBranchTag())
-	state.SourceCodeManagementURI = types.StringValue(engagement.GetSourceCodeManagementUri())
-	state.DeduplicationOnEngagement = types.BoolValue(engagement.GetDeduplicationOnEngagement())
-	state.Lead = types.Int64Value(int64(engagement.GetLead()))
-	state.Requester = types.Int64Value(int64(engagement.GetRequester()))
-	state.Preset = types.Int64Value(int64(engagement.GetPreset()))
-	state.ReportType = types.Int64Value(int64(engagement.GetReportType()))
+	state.Reason = defectdojoNullableStringToBasetypesStringValue(engagement.Reason)
+	state.Tracker = defectdojoNullableStringToBasetypesStringValue(engagement.Tracker)
+	state.TestStrategy = defectdojoNullableStringToBasetypesStringValue(engagement.TestStrategy)
+	state.ThreatModel = boolPointerToBasetypesBoolValue(engagement.ThreatModel)
+	state.APITest = boolPointerToBasetypesBoolValue(engagement.ApiTest)
+	state.PenTest = boolPointerToBasetypesBoolValue(engagement.PenTest)
+	state.CheckList = boolPointerToBasetypesBoolValue(engagement.CheckList)
+	state.Status = defectdojoNullableStringToBasetypesStringValue(engagement.Status)
+	state.EngagementType = defectdojoNullableStringToBasetypesStringValue(engagement.EngagementType)
+	state.BuildID = defectdojoNullableStringToBasetypesStringValue(engagement.BuildId)
+	state.CommitHash = defectdojoNullableStringToBasetypesStringValue(engagement.CommitHash)
+	state.BranchTag = defectdojoNullableStringToBasetypesStringValue(engagement.BranchTag)
+	state.SourceCodeManagementURI = defectdojoNullableStringToBasetypesStringValue(engagement.SourceCodeManagementUri)
+	state.DeduplicationOnEngagement = boolPointerToBasetypesBoolValue(engagement.DeduplicationOnEngagement)
+	state.Lead = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Lead)
+	state.Requester = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Requester)
+	state.Preset = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Preset)
+	state.ReportType = defectdojoNullableInt32ToBasetypesInt64Value(engagement.ReportType)
 	state.Product = types.Int64Value(int64(engagement.GetProduct()))
-	state.BuildServer = types.Int64Value(int64(engagement.GetBuildServer()))
-	state.SourceCodeManagementServer = types.Int64Value(int64(engagement.GetSourceCodeManagementServer()))
-	state.OrchestrationEngine = types.Int64Value(int64(engagement.GetOrchestrationEngine()))
+	state.BuildServer = defectdojoNullableInt32ToBasetypesInt64Value(engagement.BuildServer)
+	state.SourceCodeManagementServer = defectdojoNullableInt32ToBasetypesInt64Value(engagement.SourceCodeManagementServer)
+	state.OrchestrationEngine = defectdojoNullableInt32ToBasetypesInt64Value(engagement.OrchestrationEngine)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -446,26 +446,26 @@ func (r *engagementResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Generate request from plan
 	engagementRequest := defectdojo.EngagementRequest{
-		Name:                       *defectdojo.NewNullableString(plan.Name.ValueStringPointer()),
-		Description:                *defectdojo.NewNullableString(plan.Description.ValueStringPointer()),
-		Version:                    *defectdojo.NewNullableString(plan.Version.ValueStringPointer()),
-		FirstContacted:             *defectdojo.NewNullableString(plan.FirstContacted.ValueStringPointer()),
+		Name:                       basetypesStringValueToDefectdojoNullableString(plan.Name),
+		Description:                basetypesStringValueToDefectdojoNullableString(plan.Description),
+		Version:                    basetypesStringValueToDefectdojoNullableString(plan.Version),
+		FirstContacted:             basetypesStringValueToDefectdojoNullableString(plan.FirstContacted),
 		TargetStart:                plan.TargetStart.ValueString(),
 		TargetEnd:                  plan.TargetEnd.ValueString(),
-		Reason:                     *defectdojo.NewNullableString(plan.Reason.ValueStringPointer()),
-		Tracker:                    *defectdojo.NewNullableString(plan.Tracker.ValueStringPointer()),
-		TestStrategy:               *defectdojo.NewNullableString(plan.TestStrategy.ValueStringPointer()),
-		ThreatModel:                plan.ThreatModel.ValueBoolPointer(),
-		ApiTest:                    plan.APITest.ValueBoolPointer(),
-		PenTest:                    plan.PenTest.ValueBoolPointer(),
-		CheckList:                  plan.CheckList.ValueBoolPointer(),
+		Reason:                     basetypesStringValueToDefectdojoNullableString(plan.Reason),
+		Tracker:                    basetypesStringValueToDefectdojoNullableString(plan.Tracker),
+		TestStrategy:               basetypesStringValueToDefectdojoNullableString(plan.TestStrategy),
+		ThreatModel:                basetypesBoolValueToBoolPointer(plan.ThreatModel),
+		ApiTest:                    basetypesBoolValueToBoolPointer(plan.APITest),
+		PenTest:                    basetypesBoolValueToBoolPointer(plan.PenTest),
+		CheckList:                  basetypesBoolValueToBoolPointer(plan.CheckList),
 		Status:                     basetypesStringValueToDefectdojoNullableString(plan.Status),
 		EngagementType:             basetypesStringValueToDefectdojoNullableString(plan.EngagementType),
-		BuildId:                    *defectdojo.NewNullableString(plan.BuildID.ValueStringPointer()),
-		CommitHash:                 *defectdojo.NewNullableString(plan.CommitHash.ValueStringPointer()),
-		BranchTag:                  *defectdojo.NewNullableString(plan.BranchTag.ValueStringPointer()),
-		SourceCodeManagementUri:    *defectdojo.NewNullableString(plan.SourceCodeManagementURI.ValueStringPointer()),
-		DeduplicationOnEngagement:  plan.DeduplicationOnEngagement.ValueBoolPointer(),
+		BuildId:                    basetypesStringValueToDefectdojoNullableString(plan.BuildID),
+		CommitHash:                 basetypesStringValueToDefectdojoNullableString(plan.CommitHash),
+		BranchTag:                  basetypesStringValueToDefectdojoNullableString(plan.BranchTag),
+		SourceCodeManagementUri:    basetypesStringValueToDefectdojoNullableString(plan.SourceCodeManagementURI),
+		DeduplicationOnEngagement:  basetypesBoolValueToBoolPointer(plan.DeduplicationOnEngagement),
 		Lead:                       basetypesInt64ValueToDefectdojoNullableInt32(plan.Lead),
 		Requester:                  basetypesInt64ValueToDefectdojoNullableInt32(plan.Requester),
 		Preset:                     basetypesInt64ValueToDefectdojoNullableInt32(plan.Preset),
@@ -499,34 +499,34 @@ func (r *engagementResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(engagement.GetId()))
-	plan.Name = types.StringValue(engagement.GetName())
-	plan.Description = types.StringValue(engagement.GetDescription())
-	plan.Version = types.StringValue(engagement.GetVersion())
-	plan.FirstContacted = types.StringValue(engagement.GetFirstContacted())
+	plan.Name = defectdojoNullableStringToBasetypesStringValue(engagement.Name)
+	plan.Description = defectdojoNullableStringToBasetypesStringValue(engagement.Description)
+	plan.Version = defectdojoNullableStringToBasetypesStringValue(engagement.Version)
+	plan.FirstContacted = defectdojoNullableStringToBasetypesStringValue(engagement.FirstContacted)
 	plan.TargetStart = types.StringValue(engagement.GetTargetStart())
 	plan.TargetEnd = types.StringValue(engagement.GetTargetEnd())
-	plan.Reason = types.StringValue(engagement.GetReason())
-	plan.Tracker = types.StringValue(engagement.GetTracker())
-	plan.TestStrategy = types.StringValue(engagement.GetTestStrategy())
-	plan.ThreatModel = types.BoolValue(engagement.GetThreatModel())
-	plan.APITest = types.BoolValue(engagement.GetApiTest())
-	plan.PenTest = types.BoolValue(engagement.GetPenTest())
-	plan.CheckList = types.BoolValue(engagement.GetCheckList())
-	plan.Status = types.StringValue(engagement.GetStatus())
-	plan.EngagementType = types.StringValue(engagement.GetEngagementType())
-	plan.BuildID = types.StringValue(engagement.GetBuildId())
-	plan.CommitHash = types.StringValue(engagement.GetCommitHash())
-	plan.BranchTag = types.StringValue(engagement.GetBranchTag())
-	plan.SourceCodeManagementURI = types.StringValue(engagement.GetSourceCodeManagementUri())
-	plan.DeduplicationOnEngagement = types.BoolValue(engagement.GetDeduplicationOnEngagement())
-	plan.Lead = types.Int64Value(int64(engagement.GetLead()))
-	plan.Requester = types.Int64Value(int64(engagement.GetRequester()))
-	plan.Preset = types.Int64Value(int64(engagement.GetPreset()))
-	plan.ReportType = types.Int64Value(int64(engagement.GetReportType()))
+	plan.Reason = defectdojoNullableStringToBasetypesStringValue(engagement.Reason)
+	plan.Tracker = defectdojoNullableStringToBasetypesStringValue(engagement.Tracker)
+	plan.TestStrategy = defectdojoNullableStringToBasetypesStringValue(engagement.TestStrategy)
+	plan.ThreatModel = boolPointerToBasetypesBoolValue(engagement.ThreatModel)
+	plan.APITest = boolPointerToBasetypesBoolValue(engagement.ApiTest)
+	plan.PenTest = boolPointerToBasetypesBoolValue(engagement.PenTest)
+	plan.CheckList = boolPointerToBasetypesBoolValue(engagement.CheckList)
+	plan.Status = defectdojoNullableStringToBasetypesStringValue(engagement.Status)
+	plan.EngagementType = defectdojoNullableStringToBasetypesStringValue(engagement.EngagementType)
+	plan.BuildID = defectdojoNullableStringToBasetypesStringValue(engagement.BuildId)
+	plan.CommitHash = defectdojoNullableStringToBasetypesStringValue(engagement.CommitHash)
+	plan.BranchTag = defectdojoNullableStringToBasetypesStringValue(engagement.BranchTag)
+	plan.SourceCodeManagementURI = defectdojoNullableStringToBasetypesStringValue(engagement.SourceCodeManagementUri)
+	plan.DeduplicationOnEngagement = boolPointerToBasetypesBoolValue(engagement.DeduplicationOnEngagement)
+	plan.Lead = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Lead)
+	plan.Requester = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Requester)
+	plan.Preset = defectdojoNullableInt32ToBasetypesInt64Value(engagement.Preset)
+	plan.ReportType = defectdojoNullableInt32ToBasetypesInt64Value(engagement.ReportType)
 	plan.Product = types.Int64Value(int64(engagement.GetProduct()))
-	plan.BuildServer = types.Int64Value(int64(engagement.GetBuildServer()))
-	plan.SourceCodeManagementServer = types.Int64Value(int64(engagement.GetSourceCodeManagementServer()))
-	plan.OrchestrationEngine = types.Int64Value(int64(engagement.GetOrchestrationEngine()))
+	plan.BuildServer = defectdojoNullableInt32ToBasetypesInt64Value(engagement.BuildServer)
+	plan.SourceCodeManagementServer = defectdojoNullableInt32ToBasetypesInt64Value(engagement.SourceCodeManagementServer)
+	plan.OrchestrationEngine = defectdojoNullableInt32ToBasetypesInt64Value(engagement.OrchestrationEngine)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
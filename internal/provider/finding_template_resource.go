@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &findingTemplateResource{}
+	_ resource.ResourceWithConfigure   = &findingTemplateResource{}
+	_ resource.ResourceWithImportState = &findingTemplateResource{}
+)
+
+// NewFindingTemplateResource is a helper function to simplify the provider implementation.
+func NewFindingTemplateResource() resource.Resource {
+	return &findingTemplateResource{}
+}
+
+// findingTemplateResource is the resource implementation.
+type findingTemplateResource struct {
+	client *defectdojo.APIClient
+}
+
+type findingTemplateResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Title              types.String `tfsdk:"title"`
+	CWE                types.Int64  `tfsdk:"cwe"`
+	CVE                types.String `tfsdk:"cve"`
+	CVSSv3             types.String `tfsdk:"cvssv3"`
+	Severity           types.String `tfsdk:"severity"`
+	Description        types.String `tfsdk:"description"`
+	Mitigation         types.String `tfsdk:"mitigation"`
+	Impact             types.String `tfsdk:"impact"`
+	References         types.String `tfsdk:"references"`
+	TemplateMatch      types.Bool   `tfsdk:"template_match"`
+	TemplateMatchTitle types.Bool   `tfsdk:"template_match_title"`
+	Tags               types.List   `tfsdk:"tags"`
+}
+
+// Metadata returns the resource type name.
+func (r *findingTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_finding_template"
+}
+
+// Schema defines the schema for the resource.
+func (r *findingTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the finding template",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the finding template",
+				Required:    true,
+			},
+			"cwe": schema.Int64Attribute{
+				Description: "The CWE number of the weakness",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"cve": schema.StringAttribute{
+				Description: "The CVE of the vulnerability, e.g. CVE-2021-44228",
+				Computed:    true,
+				Optional:    true,
+			},
+			"cvssv3": schema.StringAttribute{
+				Description: "The CVSS v3 vector of the vulnerability, e.g. CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^CVSS:3\.[01]/`), "must be a CVSS v3 vector starting with CVSS:3.0/ or CVSS:3.1/"),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Description: "The severity of findings created from the template, one of Critical, High, Medium, Low or Info",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Critical", "High", "Medium", "Low", "Info"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the vulnerability",
+				Computed:    true,
+				Optional:    true,
+			},
+			"mitigation": schema.StringAttribute{
+				Description: "How the vulnerability can be mitigated",
+				Computed:    true,
+				Optional:    true,
+			},
+			"impact": schema.StringAttribute{
+				Description: "The impact of the vulnerability",
+				Computed:    true,
+				Optional:    true,
+			},
+			"references": schema.StringAttribute{
+				Description: "References for the vulnerability, e.g. links to advisories",
+				Computed:    true,
+				Optional:    true,
+			},
+			"template_match": schema.BoolAttribute{
+				Description: "Whether imported findings matching the template are updated with its mitigation, impact and references",
+				Computed:    true,
+				Optional:    true,
+			},
+			"template_match_title": schema.BoolAttribute{
+				Description: "Whether the title is used to match findings to the template in addition to the CWE",
+				Computed:    true,
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of tags for the finding template",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *findingTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *findingTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan findingTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	findingTemplateRequest := findingTemplateRequestFromPlan(plan, tags)
+
+	// Create new finding template
+	findingTemplate, res, err := r.client.FindingTemplatesAPI.FindingTemplatesCreate(ctx).FindingTemplateRequest(findingTemplateRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Finding Template",
+			"Could not create finding template, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(findingTemplate.GetId()))
+	mapFindingTemplateToModel(findingTemplate, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), findingTemplate.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *findingTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state findingTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed finding template value from Defectdojo
+	findingTemplate, res, err := r.client.FindingTemplatesAPI.FindingTemplatesRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Finding Template",
+			"Could not read finding template with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(findingTemplate.GetId()))
+	mapFindingTemplateToModel(findingTemplate, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), findingTemplate.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *findingTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan findingTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	findingTemplateRequest := findingTemplateRequestFromPlan(plan, tags)
+
+	// Update existing finding template
+	_, res, err := r.client.FindingTemplatesAPI.FindingTemplatesUpdate(ctx, int32(plan.ID.ValueInt64())).FindingTemplateRequest(findingTemplateRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Finding Template",
+			"Could not update finding template with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed finding template value from Defectdojo
+	findingTemplate, res, err := r.client.FindingTemplatesAPI.FindingTemplatesRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Finding Template",
+			"Could not read finding template with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(findingTemplate.GetId()))
+	mapFindingTemplateToModel(findingTemplate, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), findingTemplate.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *findingTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state findingTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing finding template
+	res, err := r.client.FindingTemplatesAPI.FindingTemplatesDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Finding Template",
+			"Could not delete finding template, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *findingTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// findingTemplateRequestFromPlan generates the request for creating and updating a finding template.
+func findingTemplateRequestFromPlan(plan findingTemplateResourceModel, tags []string) defectdojo.FindingTemplateRequest {
+	return defectdojo.FindingTemplateRequest{
+		Title:              plan.Title.ValueString(),
+		Cwe:                basetypesInt64ValueToDefectdojoNullableInt32(plan.CWE),
+		Cve:                basetypesStringValueToDefectdojoNullableString(plan.CVE),
+		Cvssv3:             basetypesStringValueToDefectdojoNullableString(plan.CVSSv3),
+		Severity:           basetypesStringValueToDefectdojoNullableString(plan.Severity),
+		Description:        basetypesStringValueToDefectdojoNullableString(plan.Description),
+		Mitigation:         basetypesStringValueToDefectdojoNullableString(plan.Mitigation),
+		Impact:             basetypesStringValueToDefectdojoNullableString(plan.Impact),
+		References:         basetypesStringValueToDefectdojoNullableString(plan.References),
+		TemplateMatch:      basetypesBoolValueToBoolPointer(plan.TemplateMatch),
+		TemplateMatchTitle: basetypesBoolValueToBoolPointer(plan.TemplateMatchTitle),
+		Tags:               tags,
+	}
+}
+
+// mapFindingTemplateToModel maps a finding template to the model, except for the tags.
+func mapFindingTemplateToModel(findingTemplate *defectdojo.FindingTemplate, model *findingTemplateResourceModel) {
+	model.Title = types.StringValue(findingTemplate.GetTitle())
+	model.CWE = defectdojoNullableInt32ToBasetypesInt64Value(findingTemplate.Cwe)
+	model.CVE = defectdojoNullableStringToBasetypesStringValue(findingTemplate.Cve)
+	model.CVSSv3 = defectdojoNullableStringToBasetypesStringValue(findingTemplate.Cvssv3)
+	model.Severity = defectdojoNullableStringToBasetypesStringValue(findingTemplate.Severity)
+	model.Description = defectdojoNullableStringToBasetypesStringValue(findingTemplate.Description)
+	model.Mitigation = defectdojoNullableStringToBasetypesStringValue(findingTemplate.Mitigation)
+	model.Impact = defectdojoNullableStringToBasetypesStringValue(findingTemplate.Impact)
+	model.References = defectdojoNullableStringToBasetypesStringValue(findingTemplate.References)
+	model.TemplateMatch = boolPointerToBasetypesBoolValue(findingTemplate.TemplateMatch)
+	model.TemplateMatchTitle = boolPointerToBasetypesBoolValue(findingTemplate.TemplateMatchTitle)
+}
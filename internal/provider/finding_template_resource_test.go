@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFindingTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_finding_template" "test" {
+					title       = "Test Finding Template"
+					cwe         = 79
+					severity    = "High"
+					description = "This is the description of the Test Finding Template"
+					mitigation  = "Encode all user input"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "title", "Test Finding Template"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "cwe", "79"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "severity", "High"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "description", "This is the description of the Test Finding Template"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "mitigation", "Encode all user input"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_finding_template.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_finding_template.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_finding_template" "test" {
+					title          = "Test Finding Template"
+					cwe            = 79
+					cvssv3         = "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N"
+					severity       = "Medium"
+					description    = "This is the description of the Test Finding Template"
+					mitigation     = "Encode all user input"
+					impact         = "Session hijacking"
+					references     = "https://owasp.org/www-community/attacks/xss/"
+					template_match = true
+					tags           = ["web"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "cvssv3", "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "severity", "Medium"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "impact", "Session hijacking"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "references", "https://owasp.org/www-community/attacks/xss/"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "template_match", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding_template.test", "tags.0", "web"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
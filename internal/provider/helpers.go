@@ -4,6 +4,9 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/prempador/go-defectdojo"
 )
@@ -48,3 +51,123 @@ func basetypesStringValueToDefectdojoNullableString(value basetypes.StringValue)
 	v := value.ValueString()
 	return *defectdojo.NewNullableString(&v)
 }
+
+// basetypesStringValueToStringPointer converts a basetypes.StringValue to a *string.
+// unlike ValueStringPointer, unknown values are treated as unset instead of an empty string.
+func basetypesStringValueToStringPointer(value basetypes.StringValue) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	v := value.ValueString()
+	return &v
+}
+
+// basetypesBoolValueToBoolPointer converts a basetypes.BoolValue to a *bool.
+// unlike ValueBoolPointer, unknown values are treated as unset instead of false.
+func basetypesBoolValueToBoolPointer(value basetypes.BoolValue) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	v := value.ValueBool()
+	return &v
+}
+
+// defectdojoNullableInt32ToBasetypesInt64Value converts a defectdojo.NullableInt32 to a basetypes.Int64Value.
+// an unset or null value results in a null value instead of 0.
+func defectdojoNullableInt32ToBasetypesInt64Value(value defectdojo.NullableInt32) basetypes.Int64Value {
+	return int32PointerToBasetypesInt64Value(value.Get())
+}
+
+// defectdojoNullableStringToBasetypesStringValue converts a defectdojo.NullableString to a basetypes.StringValue.
+// an unset or null value results in a null value instead of an empty string.
+func defectdojoNullableStringToBasetypesStringValue(value defectdojo.NullableString) basetypes.StringValue {
+	return stringPointerToBasetypesStringValue(value.Get())
+}
+
+// defectdojoNullableFloat64ToBasetypesStringValue converts a defectdojo.NullableFloat64 to a basetypes.StringValue.
+// this is needed for fields like the product revenue, which are decimals in the API but strings in the schema.
+func defectdojoNullableFloat64ToBasetypesStringValue(value defectdojo.NullableFloat64) basetypes.StringValue {
+	if value.Get() == nil {
+		return basetypes.NewStringNull()
+	}
+
+	return basetypes.NewStringValue(fmt.Sprintf("%f", *value.Get()))
+}
+
+// stringPointerToBasetypesStringValue converts a *string to a basetypes.StringValue.
+func stringPointerToBasetypesStringValue(value *string) basetypes.StringValue {
+	if value == nil {
+		return basetypes.NewStringNull()
+	}
+
+	return basetypes.NewStringValue(*value)
+}
+
+// emptyStringToBasetypesStringNull converts a string to a basetypes.StringValue.
+// an empty string results in a null value.
+func emptyStringToBasetypesStringNull(value string) basetypes.StringValue {
+	if value == "" {
+		return basetypes.NewStringNull()
+	}
+
+	return basetypes.NewStringValue(value)
+}
+
+// boolPointerToBasetypesBoolValue converts a *bool to a basetypes.BoolValue.
+func boolPointerToBasetypesBoolValue(value *bool) basetypes.BoolValue {
+	if value == nil {
+		return basetypes.NewBoolNull()
+	}
+
+	return basetypes.NewBoolValue(*value)
+}
+
+// pageSize is the number of results requested per page when going through all pages of a list.
+const pageSize = 100
+
+// listPage fetches the page of a list starting at offset.
+// it returns the results of the page and whether there are more pages.
+type listPage[T any] func(offset int32) ([]T, bool, *http.Response, error)
+
+// listAllPages goes through all pages of a list and returns all results.
+// most list endpoints of the API can't filter by the attributes we look up,
+// so lookups have to go through all pages.
+func listAllPages[T any](fetch listPage[T]) ([]T, *http.Response, error) {
+	all := make([]T, 0)
+
+	for offset := int32(0); ; offset += pageSize {
+		results, more, res, err := fetch(offset)
+		if err != nil {
+			return nil, res, err
+		}
+
+		all = append(all, results...)
+
+		if !more {
+			return all, res, nil
+		}
+	}
+}
+
+// findInPages goes through the pages of a list until match returns true for a result.
+// it returns nil if no result matches.
+func findInPages[T any](fetch listPage[T], match func(*T) bool) (*T, *http.Response, error) {
+	for offset := int32(0); ; offset += pageSize {
+		results, more, res, err := fetch(offset)
+		if err != nil {
+			return nil, res, err
+		}
+
+		for i := range results {
+			if match(&results[i]) {
+				return &results[i], res, nil
+			}
+		}
+
+		if !more {
+			return nil, res, nil
+		}
+	}
+}
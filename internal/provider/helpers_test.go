@@ -4,6 +4,8 @@
 package provider
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -97,3 +99,199 @@ func TestUnitBasetypesStringValueToDefectdojoNullableStringValue(t *testing.T) {
 
 	require.Equal(t, *dNullString, result)
 }
+
+func TestUnitBasetypesStringValueToStringPointerNull(t *testing.T) {
+	value := basetypes.NewStringNull()
+	var s *string
+
+	result := basetypesStringValueToStringPointer(value)
+
+	require.Equal(t, s, result)
+}
+
+func TestUnitBasetypesStringValueToStringPointerUnknown(t *testing.T) {
+	value := basetypes.NewStringUnknown()
+	var s *string
+
+	result := basetypesStringValueToStringPointer(value)
+
+	require.Equal(t, s, result)
+}
+
+func TestUnitBasetypesStringValueToStringPointerValue(t *testing.T) {
+	s := "asdf"
+	value := basetypes.NewStringValue(s)
+
+	result := basetypesStringValueToStringPointer(value)
+
+	require.Equal(t, &s, result)
+}
+
+func TestUnitBasetypesBoolValueToBoolPointerNull(t *testing.T) {
+	value := basetypes.NewBoolNull()
+	var b *bool
+
+	result := basetypesBoolValueToBoolPointer(value)
+
+	require.Equal(t, b, result)
+}
+
+func TestUnitBasetypesBoolValueToBoolPointerUnknown(t *testing.T) {
+	value := basetypes.NewBoolUnknown()
+	var b *bool
+
+	result := basetypesBoolValueToBoolPointer(value)
+
+	require.Equal(t, b, result)
+}
+
+func TestUnitBasetypesBoolValueToBoolPointerValue(t *testing.T) {
+	b := true
+	value := basetypes.NewBoolValue(b)
+
+	result := basetypesBoolValueToBoolPointer(value)
+
+	require.Equal(t, &b, result)
+}
+
+func TestUnitDefectdojoNullableInt32ToBasetypesInt64ValueNull(t *testing.T) {
+	value := defectdojo.NewNullableInt32(nil)
+
+	result := defectdojoNullableInt32ToBasetypesInt64Value(*value)
+
+	require.Equal(t, basetypes.NewInt64Null(), result)
+}
+
+func TestUnitDefectdojoNullableInt32ToBasetypesInt64ValueUnset(t *testing.T) {
+	var value defectdojo.NullableInt32
+
+	result := defectdojoNullableInt32ToBasetypesInt64Value(value)
+
+	require.Equal(t, basetypes.NewInt64Null(), result)
+}
+
+func TestUnitDefectdojoNullableInt32ToBasetypesInt64ValueValue(t *testing.T) {
+	i32 := int32(1)
+	value := defectdojo.NewNullableInt32(&i32)
+
+	result := defectdojoNullableInt32ToBasetypesInt64Value(*value)
+
+	require.Equal(t, basetypes.NewInt64Value(1), result)
+}
+
+func TestUnitDefectdojoNullableStringToBasetypesStringValueNull(t *testing.T) {
+	value := defectdojo.NewNullableString(nil)
+
+	result := defectdojoNullableStringToBasetypesStringValue(*value)
+
+	require.Equal(t, basetypes.NewStringNull(), result)
+}
+
+func TestUnitDefectdojoNullableStringToBasetypesStringValueValue(t *testing.T) {
+	s := "asdf"
+	value := defectdojo.NewNullableString(&s)
+
+	result := defectdojoNullableStringToBasetypesStringValue(*value)
+
+	require.Equal(t, basetypes.NewStringValue(s), result)
+}
+
+func TestUnitDefectdojoNullableFloat64ToBasetypesStringValueNull(t *testing.T) {
+	value := defectdojo.NewNullableFloat64(nil)
+
+	result := defectdojoNullableFloat64ToBasetypesStringValue(*value)
+
+	require.Equal(t, basetypes.NewStringNull(), result)
+}
+
+func TestUnitDefectdojoNullableFloat64ToBasetypesStringValueValue(t *testing.T) {
+	f := 1.5
+	value := defectdojo.NewNullableFloat64(&f)
+
+	result := defectdojoNullableFloat64ToBasetypesStringValue(*value)
+
+	require.Equal(t, basetypes.NewStringValue("1.500000"), result)
+}
+
+func TestUnitStringPointerToBasetypesStringValueNull(t *testing.T) {
+	result := stringPointerToBasetypesStringValue(nil)
+
+	require.Equal(t, basetypes.NewStringNull(), result)
+}
+
+func TestUnitStringPointerToBasetypesStringValueValue(t *testing.T) {
+	s := "asdf"
+
+	result := stringPointerToBasetypesStringValue(&s)
+
+	require.Equal(t, basetypes.NewStringValue(s), result)
+}
+
+func TestUnitEmptyStringToBasetypesStringNullEmpty(t *testing.T) {
+	result := emptyStringToBasetypesStringNull("")
+
+	require.Equal(t, basetypes.NewStringNull(), result)
+}
+
+func TestUnitEmptyStringToBasetypesStringNullValue(t *testing.T) {
+	result := emptyStringToBasetypesStringNull("asdf")
+
+	require.Equal(t, basetypes.NewStringValue("asdf"), result)
+}
+
+func TestUnitBoolPointerToBasetypesBoolValueNull(t *testing.T) {
+	result := boolPointerToBasetypesBoolValue(nil)
+
+	require.Equal(t, basetypes.NewBoolNull(), result)
+}
+
+func TestUnitBoolPointerToBasetypesBoolValueValue(t *testing.T) {
+	b := true
+
+	result := boolPointerToBasetypesBoolValue(&b)
+
+	require.Equal(t, basetypes.NewBoolValue(b), result)
+}
+
+// testListPage serves results in pages of two, one page per pageSize offset.
+func testListPage(results []int, calls *int) listPage[int] {
+	return func(offset int32) ([]int, bool, *http.Response, error) {
+		*calls++
+		start := min(int(offset/pageSize)*2, len(results))
+		end := min(start+2, len(results))
+
+		return results[start:end], end < len(results), nil, nil
+	}
+}
+
+func TestUnitListAllPages(t *testing.T) {
+	calls := 0
+
+	result, _, err := listAllPages(testListPage([]int{1, 2, 3, 4, 5}, &calls))
+
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, result)
+	require.Equal(t, 3, calls)
+}
+
+func TestUnitFindInPages(t *testing.T) {
+	calls := 0
+	fetch := testListPage([]int{1, 2, 3, 4, 5}, &calls)
+
+	result, _, err := findInPages(fetch, func(i *int) bool { return *i == 3 })
+	require.NoError(t, err)
+	require.Equal(t, 3, *result)
+	require.Equal(t, 2, calls)
+
+	result, _, err = findInPages(fetch, func(i *int) bool { return *i == 6 })
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestUnitFindInPagesError(t *testing.T) {
+	_, _, err := findInPages(func(offset int32) ([]int, bool, *http.Response, error) {
+		return nil, false, nil, errors.New("unavailable")
+	}, func(i *int) bool { return true })
+
+	require.Error(t, err)
+}
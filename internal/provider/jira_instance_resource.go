@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jiraInstanceResource{}
+	_ resource.ResourceWithConfigure   = &jiraInstanceResource{}
+	_ resource.ResourceWithImportState = &jiraInstanceResource{}
+)
+
+// NewJiraInstanceResource is a helper function to simplify the provider implementation.
+func NewJiraInstanceResource() resource.Resource {
+	return &jiraInstanceResource{}
+}
+
+// jiraInstanceResource is the resource implementation.
+type jiraInstanceResource struct {
+	client *defectdojo.APIClient
+}
+
+type jiraInstanceResourceModel struct {
+	ID                             types.Int64  `tfsdk:"id"`
+	ConfigurationName              types.String `tfsdk:"configuration_name"`
+	URL                            types.String `tfsdk:"url"`
+	Username                       types.String `tfsdk:"username"`
+	Password                       types.String `tfsdk:"password"`
+	PasswordVersion                types.Int64  `tfsdk:"password_version"`
+	DefaultIssueType               types.String `tfsdk:"default_issue_type"`
+	IssueTemplateDir               types.String `tfsdk:"issue_template_dir"`
+	EpicNameID                     types.Int64  `tfsdk:"epic_name_id"`
+	OpenStatusKey                  types.Int64  `tfsdk:"open_status_key"`
+	CloseStatusKey                 types.Int64  `tfsdk:"close_status_key"`
+	InfoMappingSeverity            types.String `tfsdk:"info_mapping_severity"`
+	LowMappingSeverity             types.String `tfsdk:"low_mapping_severity"`
+	MediumMappingSeverity          types.String `tfsdk:"medium_mapping_severity"`
+	HighMappingSeverity            types.String `tfsdk:"high_mapping_severity"`
+	CriticalMappingSeverity        types.String `tfsdk:"critical_mapping_severity"`
+	FindingText                    types.String `tfsdk:"finding_text"`
+	AcceptedMappingResolution      types.String `tfsdk:"accepted_mapping_resolution"`
+	FalsePositiveMappingResolution types.String `tfsdk:"false_positive_mapping_resolution"`
+	GlobalJiraSLANotification      types.Bool   `tfsdk:"global_jira_sla_notification"`
+	FindingJiraSync                types.Bool   `tfsdk:"finding_jira_sync"`
+}
+
+// Metadata returns the resource type name.
+func (r *jiraInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_instance"
+}
+
+// Schema defines the schema for the resource.
+func (r *jiraInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the JIRA instance",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"configuration_name": schema.StringAttribute{
+				Description: "Enter a name to give to this configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the JIRA instance, e.g. https://jira.example.com",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username or email address of the JIRA user",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password or API token of the JIRA user. The password is write-only and never stored in the state, which requires Terraform 1.11 or later",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_version": schema.Int64Attribute{
+				Description: "Changing this value updates the JIRA instance with the current value of password. Defectdojo does not return the password, so this is the only way to detect a rotation",
+				Optional:    true,
+			},
+			"default_issue_type": schema.StringAttribute{
+				Description: "The default issue type, one of Task, Story, Epic, Spike, Bug or Security",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Task", "Story", "Epic", "Spike", "Bug", "Security"),
+				},
+			},
+			"issue_template_dir": schema.StringAttribute{
+				Description: "Choose the folder containing the Django templates used to render the JIRA issue description",
+				Computed:    true,
+				Optional:    true,
+			},
+			"epic_name_id": schema.Int64Attribute{
+				Description: "The ID of the JIRA custom field for the epic name, e.g. 10011 for customfield_10011",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"open_status_key": schema.Int64Attribute{
+				Description: "The transition ID to re-open JIRA issues",
+				Required:    true,
+			},
+			"close_status_key": schema.Int64Attribute{
+				Description: "The transition ID to close JIRA issues",
+				Required:    true,
+			},
+			"info_mapping_severity": schema.StringAttribute{
+				Description: "The JIRA priority of info findings, e.g. Trivial",
+				Required:    true,
+			},
+			"low_mapping_severity": schema.StringAttribute{
+				Description: "The JIRA priority of low findings, e.g. Minor",
+				Required:    true,
+			},
+			"medium_mapping_severity": schema.StringAttribute{
+				Description: "The JIRA priority of medium findings, e.g. Major",
+				Required:    true,
+			},
+			"high_mapping_severity": schema.StringAttribute{
+				Description: "The JIRA priority of high findings, e.g. Critical",
+				Required:    true,
+			},
+			"critical_mapping_severity": schema.StringAttribute{
+				Description: "The JIRA priority of critical findings, e.g. Blocker",
+				Required:    true,
+			},
+			"finding_text": schema.StringAttribute{
+				Description: "Additional text that will be added to the finding in JIRA, e.g. for routing or references",
+				Computed:    true,
+				Optional:    true,
+			},
+			"accepted_mapping_resolution": schema.StringAttribute{
+				Description: "JIRA resolution names that will mark findings as accepted, comma separated",
+				Computed:    true,
+				Optional:    true,
+			},
+			"false_positive_mapping_resolution": schema.StringAttribute{
+				Description: "JIRA resolution names that will mark findings as false positive, comma separated",
+				Computed:    true,
+				Optional:    true,
+			},
+			"global_jira_sla_notification": schema.BoolAttribute{
+				Description: "Whether to send SLA notifications as comments to JIRA issues",
+				Computed:    true,
+				Optional:    true,
+			},
+			"finding_jira_sync": schema.BoolAttribute{
+				Description: "Whether to sync the finding with the JIRA issue, e.g. on changes of the severity",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jiraInstanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jiraInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jiraInstanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only values are only available in the config
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	jiraInstanceRequest := jiraInstanceRequestFromPlan(plan, password)
+
+	// Create new JIRA instance
+	jiraInstance, res, err := r.client.JiraInstancesAPI.JiraInstancesCreate(ctx).JIRAInstanceRequest(jiraInstanceRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo JIRA Instance",
+			"Could not create JIRA instance, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(jiraInstance.GetId()))
+	mapJiraInstanceToModel(jiraInstance, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jiraInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jiraInstanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed JIRA instance value from Defectdojo
+	jiraInstance, res, err := r.client.JiraInstancesAPI.JiraInstancesRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo JIRA Instance",
+			"Could not read JIRA instance with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(jiraInstance.GetId()))
+	mapJiraInstanceToModel(jiraInstance, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jiraInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jiraInstanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defectdojo requires the password on every update
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	jiraInstanceRequest := jiraInstanceRequestFromPlan(plan, password)
+
+	// Update existing JIRA instance
+	_, res, err := r.client.JiraInstancesAPI.JiraInstancesUpdate(ctx, int32(plan.ID.ValueInt64())).JIRAInstanceRequest(jiraInstanceRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo JIRA Instance",
+			"Could not update JIRA instance with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed JIRA instance value from Defectdojo
+	jiraInstance, res, err := r.client.JiraInstancesAPI.JiraInstancesRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo JIRA Instance",
+			"Could not read JIRA instance with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(jiraInstance.GetId()))
+	mapJiraInstanceToModel(jiraInstance, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jiraInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jiraInstanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing JIRA instance
+	res, err := r.client.JiraInstancesAPI.JiraInstancesDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo JIRA Instance",
+			"Could not delete JIRA instance, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *jiraInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// jiraInstanceRequestFromPlan generates the request for creating and updating a JIRA instance.
+// the password is passed separately because it is write-only and therefore not part of the plan.
+func jiraInstanceRequestFromPlan(plan jiraInstanceResourceModel, password types.String) defectdojo.JIRAInstanceRequest {
+	return defectdojo.JIRAInstanceRequest{
+		ConfigurationName:              basetypesStringValueToStringPointer(plan.ConfigurationName),
+		Url:                            plan.URL.ValueString(),
+		Username:                       plan.Username.ValueString(),
+		Password:                       password.ValueString(),
+		DefaultIssueType:               basetypesStringValueToStringPointer(plan.DefaultIssueType),
+		IssueTemplateDir:               basetypesStringValueToDefectdojoNullableString(plan.IssueTemplateDir),
+		EpicNameId:                     int32(plan.EpicNameID.ValueInt64()),
+		OpenStatusKey:                  int32(plan.OpenStatusKey.ValueInt64()),
+		CloseStatusKey:                 int32(plan.CloseStatusKey.ValueInt64()),
+		InfoMappingSeverity:            plan.InfoMappingSeverity.ValueString(),
+		LowMappingSeverity:             plan.LowMappingSeverity.ValueString(),
+		MediumMappingSeverity:          plan.MediumMappingSeverity.ValueString(),
+		HighMappingSeverity:            plan.HighMappingSeverity.ValueString(),
+		CriticalMappingSeverity:        plan.CriticalMappingSeverity.ValueString(),
+		FindingText:                    basetypesStringValueToDefectdojoNullableString(plan.FindingText),
+		AcceptedMappingResolution:      basetypesStringValueToDefectdojoNullableString(plan.AcceptedMappingResolution),
+		FalsePositiveMappingResolution: basetypesStringValueToDefectdojoNullableString(plan.FalsePositiveMappingResolution),
+		GlobalJiraSlaNotification:      basetypesBoolValueToBoolPointer(plan.GlobalJiraSLANotification),
+		FindingJiraSync:                basetypesBoolValueToBoolPointer(plan.FindingJiraSync),
+	}
+}
+
+// mapJiraInstanceToModel maps a JIRA instance to the model.
+// the password and its version are left untouched as Defectdojo never returns the password.
+func mapJiraInstanceToModel(jiraInstance *defectdojo.JIRAInstance, model *jiraInstanceResourceModel) {
+	model.ConfigurationName = stringPointerToBasetypesStringValue(jiraInstance.ConfigurationName)
+	model.URL = types.StringValue(jiraInstance.GetUrl())
+	model.Username = types.StringValue(jiraInstance.GetUsername())
+	model.DefaultIssueType = stringPointerToBasetypesStringValue(jiraInstance.DefaultIssueType)
+	model.IssueTemplateDir = defectdojoNullableStringToBasetypesStringValue(jiraInstance.IssueTemplateDir)
+	model.EpicNameID = types.Int64Value(int64(jiraInstance.GetEpicNameId()))
+	model.OpenStatusKey = types.Int64Value(int64(jiraInstance.GetOpenStatusKey()))
+	model.CloseStatusKey = types.Int64Value(int64(jiraInstance.GetCloseStatusKey()))
+	model.InfoMappingSeverity = types.StringValue(jiraInstance.GetInfoMappingSeverity())
+	model.LowMappingSeverity = types.StringValue(jiraInstance.GetLowMappingSeverity())
+	model.MediumMappingSeverity = types.StringValue(jiraInstance.GetMediumMappingSeverity())
+	model.HighMappingSeverity = types.StringValue(jiraInstance.GetHighMappingSeverity())
+	model.CriticalMappingSeverity = types.StringValue(jiraInstance.GetCriticalMappingSeverity())
+	model.FindingText = defectdojoNullableStringToBasetypesStringValue(jiraInstance.FindingText)
+	model.AcceptedMappingResolution = defectdojoNullableStringToBasetypesStringValue(jiraInstance.AcceptedMappingResolution)
+	model.FalsePositiveMappingResolution = defectdojoNullableStringToBasetypesStringValue(jiraInstance.FalsePositiveMappingResolution)
+	model.GlobalJiraSLANotification = boolPointerToBasetypesBoolValue(jiraInstance.GlobalJiraSlaNotification)
+	model.FindingJiraSync = boolPointerToBasetypesBoolValue(jiraInstance.FindingJiraSync)
+}
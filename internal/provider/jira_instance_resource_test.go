@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJiraInstanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks:   testAccWriteOnlyVersionChecks,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_jira_instance" "test" {
+					configuration_name        = "Test JIRA"
+					url                       = "https://jira.example.com"
+					username                  = "defectdojo@example.com"
+					password                  = "api-token"
+					default_issue_type        = "Bug"
+					epic_name_id              = 10011
+					open_status_key           = 11
+					close_status_key          = 31
+					info_mapping_severity     = "Trivial"
+					low_mapping_severity      = "Minor"
+					medium_mapping_severity   = "Major"
+					high_mapping_severity     = "Critical"
+					critical_mapping_severity = "Blocker"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "configuration_name", "Test JIRA"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "url", "https://jira.example.com"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "default_issue_type", "Bug"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "epic_name_id", "10011"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "critical_mapping_severity", "Blocker"),
+
+					// Verify the password is not stored in the state
+					resource.TestCheckNoResourceAttr("defectdojo_jira_instance.test", "password"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_jira_instance.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_jira_instance.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_jira_instance" "test" {
+					configuration_name        = "Test JIRA"
+					url                       = "https://jira.example.com"
+					username                  = "defectdojo@example.com"
+					password                  = "rotated-api-token"
+					password_version          = 1
+					default_issue_type        = "Security"
+					epic_name_id              = 10011
+					open_status_key           = 11
+					close_status_key          = 31
+					info_mapping_severity     = "Trivial"
+					low_mapping_severity      = "Minor"
+					medium_mapping_severity   = "Major"
+					high_mapping_severity     = "Critical"
+					critical_mapping_severity = "Blocker"
+					finding_text              = "Reported by Defectdojo"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "default_issue_type", "Security"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "finding_text", "Reported by Defectdojo"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "password_version", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jiraProductConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &jiraProductConfigurationResource{}
+	_ resource.ResourceWithImportState = &jiraProductConfigurationResource{}
+)
+
+// NewJiraProductConfigurationResource is a helper function to simplify the provider implementation.
+func NewJiraProductConfigurationResource() resource.Resource {
+	return &jiraProductConfigurationResource{}
+}
+
+// jiraProductConfigurationResource is the resource implementation.
+type jiraProductConfigurationResource struct {
+	client *defectdojo.APIClient
+}
+
+type jiraProductConfigurationResourceModel struct {
+	ID                                   types.Int64  `tfsdk:"id"`
+	Product                              types.Int64  `tfsdk:"product"`
+	Engagement                           types.Int64  `tfsdk:"engagement"`
+	JiraInstance                         types.Int64  `tfsdk:"jira_instance"`
+	ProjectKey                           types.String `tfsdk:"project_key"`
+	Component                            types.String `tfsdk:"component"`
+	IssueTemplateDir                     types.String `tfsdk:"issue_template_dir"`
+	DefaultAssignee                      types.String `tfsdk:"default_assignee"`
+	JiraLabels                           types.String `tfsdk:"jira_labels"`
+	AddVulnerabilityIDToJiraLabel        types.Bool   `tfsdk:"add_vulnerability_id_to_jira_label"`
+	PushAllIssues                        types.Bool   `tfsdk:"push_all_issues"`
+	EnableEngagementEpicMapping          types.Bool   `tfsdk:"enable_engagement_epic_mapping"`
+	EpicIssueTypeName                    types.String `tfsdk:"epic_issue_type_name"`
+	PushNotes                            types.Bool   `tfsdk:"push_notes"`
+	ProductJiraSLANotification           types.Bool   `tfsdk:"product_jira_sla_notification"`
+	RiskAcceptanceExpirationNotification types.Bool   `tfsdk:"risk_acceptance_expiration_notification"`
+}
+
+// Metadata returns the resource type name.
+func (r *jiraProductConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_product_configuration"
+}
+
+// Schema defines the schema for the resource.
+func (r *jiraProductConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the JIRA product configuration",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"product": schema.Int64Attribute{
+				Description: "The product ID of the JIRA configuration. Exactly one of product and engagement has to be set",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("engagement")),
+				},
+			},
+			"engagement": schema.Int64Attribute{
+				Description: "The engagement ID of the JIRA configuration, to override the configuration of the product for a single engagement",
+				Optional:    true,
+			},
+			"jira_instance": schema.Int64Attribute{
+				Description: "The ID of the JIRA instance",
+				Required:    true,
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the JIRA project, e.g. SEC",
+				Computed:    true,
+				Optional:    true,
+			},
+			"component": schema.StringAttribute{
+				Description: "The JIRA component new issues are assigned to",
+				Computed:    true,
+				Optional:    true,
+			},
+			"issue_template_dir": schema.StringAttribute{
+				Description: "Choose the folder containing the Django templates used to render the JIRA issue description. Leave empty to use the template of the JIRA instance",
+				Computed:    true,
+				Optional:    true,
+			},
+			"default_assignee": schema.StringAttribute{
+				Description: "JIRA default assignee (name). If left blank then it defaults to whatever is configured in JIRA",
+				Computed:    true,
+				Optional:    true,
+			},
+			"jira_labels": schema.StringAttribute{
+				Description: "JIRA issue labels space separated",
+				Computed:    true,
+				Optional:    true,
+			},
+			"add_vulnerability_id_to_jira_label": schema.BoolAttribute{
+				Description: "Whether to add the vulnerability IDs as labels to JIRA issues",
+				Computed:    true,
+				Optional:    true,
+			},
+			"push_all_issues": schema.BoolAttribute{
+				Description: "Automatically maintain parity with JIRA. Always create and update JIRA tickets for findings in this product",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_engagement_epic_mapping": schema.BoolAttribute{
+				Description: "Whether to create an epic in JIRA for every engagement",
+				Computed:    true,
+				Optional:    true,
+			},
+			"epic_issue_type_name": schema.StringAttribute{
+				Description: "The name of the of structure that represents an Epic",
+				Computed:    true,
+				Optional:    true,
+			},
+			"push_notes": schema.BoolAttribute{
+				Description: "Whether to push notes of findings as comments to JIRA",
+				Computed:    true,
+				Optional:    true,
+			},
+			"product_jira_sla_notification": schema.BoolAttribute{
+				Description: "Whether to send SLA notifications as comments to JIRA issues",
+				Computed:    true,
+				Optional:    true,
+			},
+			"risk_acceptance_expiration_notification": schema.BoolAttribute{
+				Description: "Whether to send risk acceptance expiration notifications as comments to JIRA issues",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jiraProductConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jiraProductConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan jiraProductConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	jiraProjectRequest := jiraProjectRequestFromPlan(plan)
+
+	// Create new JIRA product configuration
+	jiraProject, res, err := r.client.JiraProductConfigurationsAPI.JiraProductConfigurationsCreate(ctx).JIRAProjectRequest(jiraProjectRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo JIRA Product Configuration",
+			"Could not create JIRA product configuration, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(jiraProject.GetId()))
+	mapJiraProjectToModel(jiraProject, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jiraProductConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state jiraProductConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed JIRA product configuration value from Defectdojo
+	jiraProject, res, err := r.client.JiraProductConfigurationsAPI.JiraProductConfigurationsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo JIRA Product Configuration",
+			"Could not read JIRA product configuration with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(jiraProject.GetId()))
+	mapJiraProjectToModel(jiraProject, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jiraProductConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan jiraProductConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	jiraProjectRequest := jiraProjectRequestFromPlan(plan)
+
+	// Update existing JIRA product configuration
+	_, res, err := r.client.JiraProductConfigurationsAPI.JiraProductConfigurationsUpdate(ctx, int32(plan.ID.ValueInt64())).JIRAProjectRequest(jiraProjectRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo JIRA Product Configuration",
+			"Could not update JIRA product configuration with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed JIRA product configuration value from Defectdojo
+	jiraProject, res, err := r.client.JiraProductConfigurationsAPI.JiraProductConfigurationsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo JIRA Product Configuration",
+			"Could not read JIRA product configuration with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(jiraProject.GetId()))
+	mapJiraProjectToModel(jiraProject, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jiraProductConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state jiraProductConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing JIRA product configuration
+	res, err := r.client.JiraProductConfigurationsAPI.JiraProductConfigurationsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo JIRA Product Configuration",
+			"Could not delete JIRA product configuration, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *jiraProductConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// jiraProjectRequestFromPlan generates the request for creating and updating a JIRA product configuration.
+func jiraProjectRequestFromPlan(plan jiraProductConfigurationResourceModel) defectdojo.JIRAProjectRequest {
+	return defectdojo.JIRAProjectRequest{
+		Product:                              basetypesInt64ValueToDefectdojoNullableInt32(plan.Product),
+		Engagement:                           basetypesInt64ValueToDefectdojoNullableInt32(plan.Engagement),
+		JiraInstance:                         basetypesInt64ValueToDefectdojoNullableInt32(plan.JiraInstance),
+		ProjectKey:                           basetypesStringValueToStringPointer(plan.ProjectKey),
+		Component:                            basetypesStringValueToStringPointer(plan.Component),
+		IssueTemplateDir:                     basetypesStringValueToDefectdojoNullableString(plan.IssueTemplateDir),
+		DefaultAssignee:                      basetypesStringValueToDefectdojoNullableString(plan.DefaultAssignee),
+		JiraLabels:                           basetypesStringValueToDefectdojoNullableString(plan.JiraLabels),
+		AddVulnerabilityIdToJiraLabel:        basetypesBoolValueToBoolPointer(plan.AddVulnerabilityIDToJiraLabel),
+		PushAllIssues:                        basetypesBoolValueToBoolPointer(plan.PushAllIssues),
+		EnableEngagementEpicMapping:          basetypesBoolValueToBoolPointer(plan.EnableEngagementEpicMapping),
+		EpicIssueTypeName:                    basetypesStringValueToStringPointer(plan.EpicIssueTypeName),
+		PushNotes:                            basetypesBoolValueToBoolPointer(plan.PushNotes),
+		ProductJiraSlaNotification:           basetypesBoolValueToBoolPointer(plan.ProductJiraSLANotification),
+		RiskAcceptanceExpirationNotification: basetypesBoolValueToBoolPointer(plan.RiskAcceptanceExpirationNotification),
+	}
+}
+
+// mapJiraProjectToModel maps a JIRA product configuration to the model.
+func mapJiraProjectToModel(jiraProject *defectdojo.JIRAProject, model *jiraProductConfigurationResourceModel) {
+	model.Product = defectdojoNullableInt32ToBasetypesInt64Value(jiraProject.Product)
+	model.Engagement = defectdojoNullableInt32ToBasetypesInt64Value(jiraProject.Engagement)
+	model.JiraInstance = defectdojoNullableInt32ToBasetypesInt64Value(jiraProject.JiraInstance)
+	model.ProjectKey = stringPointerToBasetypesStringValue(jiraProject.ProjectKey)
+	model.Component = stringPointerToBasetypesStringValue(jiraProject.Component)
+	model.IssueTemplateDir = defectdojoNullableStringToBasetypesStringValue(jiraProject.IssueTemplateDir)
+	model.DefaultAssignee = defectdojoNullableStringToBasetypesStringValue(jiraProject.DefaultAssignee)
+	model.JiraLabels = defectdojoNullableStringToBasetypesStringValue(jiraProject.JiraLabels)
+	model.AddVulnerabilityIDToJiraLabel = boolPointerToBasetypesBoolValue(jiraProject.AddVulnerabilityIdToJiraLabel)
+	model.PushAllIssues = boolPointerToBasetypesBoolValue(jiraProject.PushAllIssues)
+	model.EnableEngagementEpicMapping = boolPointerToBasetypesBoolValue(jiraProject.EnableEngagementEpicMapping)
+	model.EpicIssueTypeName = stringPointerToBasetypesStringValue(jiraProject.EpicIssueTypeName)
+	model.PushNotes = boolPointerToBasetypesBoolValue(jiraProject.PushNotes)
+	model.ProductJiraSLANotification = boolPointerToBasetypesBoolValue(jiraProject.ProductJiraSlaNotification)
+	model.RiskAcceptanceExpirationNotification = boolPointerToBasetypesBoolValue(jiraProject.RiskAcceptanceExpirationNotification)
+}
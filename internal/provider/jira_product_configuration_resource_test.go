@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccJiraProductConfigurationDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_jira_instance" "test_jira_instance" {
+	url                       = "https://jira.example.com"
+	username                  = "defectdojo@example.com"
+	password                  = "api-token"
+	epic_name_id              = 10011
+	open_status_key           = 11
+	close_status_key          = 31
+	info_mapping_severity     = "Trivial"
+	low_mapping_severity      = "Minor"
+	medium_mapping_severity   = "Major"
+	high_mapping_severity     = "Critical"
+	critical_mapping_severity = "Blocker"
+}
+`
+
+func TestAccJiraProductConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks:   testAccWriteOnlyVersionChecks,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccJiraProductConfigurationDependencies + `
+				resource "defectdojo_jira_product_configuration" "test" {
+					product         = defectdojo_product.test_product.id
+					jira_instance   = defectdojo_jira_instance.test_jira_instance.id
+					project_key     = "SEC"
+					component       = "Findings"
+					push_all_issues = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttrPair("defectdojo_jira_product_configuration.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_jira_product_configuration.test", "jira_instance", "defectdojo_jira_instance.test_jira_instance", "id"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "project_key", "SEC"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "component", "Findings"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "push_all_issues", "true"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_jira_product_configuration.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_jira_product_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccJiraProductConfigurationDependencies + `
+				resource "defectdojo_jira_product_configuration" "test" {
+					product                        = defectdojo_product.test_product.id
+					jira_instance                  = defectdojo_jira_instance.test_jira_instance.id
+					project_key                    = "APPSEC"
+					component                      = "Findings"
+					push_all_issues                = false
+					enable_engagement_epic_mapping = true
+					push_notes                     = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "project_key", "APPSEC"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "push_all_issues", "false"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "enable_engagement_epic_mapping", "true"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "push_notes", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &LanguageTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &LanguageTypesDataSource{}
+)
+
+func NewLanguageTypesDataSource() datasource.DataSource {
+	return &LanguageTypesDataSource{}
+}
+
+// LanguageTypesDataSource defines the data source implementation.
+type LanguageTypesDataSource struct {
+	client *defectdojo.APIClient
+}
+
+// LanguageTypesDataSourceModel describes the data source data model.
+type LanguageTypesDataSourceModel struct {
+	LanguageTypes []languageTypeModel `tfsdk:"language_types"`
+}
+
+// languageTypeModel describes the data source data model.
+type languageTypeModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Language types.String `tfsdk:"language"`
+	Color    types.String `tfsdk:"color"`
+}
+
+func (d *LanguageTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_language_types"
+}
+
+func (d *LanguageTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"language_types": schema.ListNestedAttribute{
+				Description: "Language Types",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The unique identifier for the language type",
+							Computed:    true,
+						},
+						"language": schema.StringAttribute{
+							Description: "The name of the language, e.g. Go",
+							Computed:    true,
+						},
+						"color": schema.StringAttribute{
+							Description: "The color of the language in the Defectdojo UI",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LanguageTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *LanguageTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state LanguageTypesDataSourceModel
+
+	// Defectdojo ships with a few hundred language types, so we have to go through all pages
+	languageTypes, res, err := listAllPages(func(offset int32) ([]defectdojo.LanguageType, bool, *http.Response, error) {
+		languageTypes, res, err := d.client.LanguageTypesAPI.LanguageTypesList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return languageTypes.Results, languageTypes.Next.Get() != nil, res, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Language Types",
+			"Could not read language types, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to model
+	for _, languageType := range languageTypes {
+		state.LanguageTypes = append(state.LanguageTypes, languageTypeModel{
+			ID:       types.Int64Value(int64(languageType.GetId())),
+			Language: types.StringValue(languageType.GetLanguage()),
+			Color:    defectdojoNullableStringToBasetypesStringValue(languageType.Color),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLanguageTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "defectdojo_language_types" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the language types shipped with Defectdojo are returned
+					resource.TestCheckResourceAttrSet("data.defectdojo_language_types.test", "language_types.0.id"),
+					resource.TestCheckResourceAttrSet("data.defectdojo_language_types.test", "language_types.0.language"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &metadataResource{}
+	_ resource.ResourceWithConfigure   = &metadataResource{}
+	_ resource.ResourceWithImportState = &metadataResource{}
+)
+
+// NewMetadataResource is a helper function to simplify the provider implementation.
+func NewMetadataResource() resource.Resource {
+	return &metadataResource{}
+}
+
+// metadataResource is the resource implementation.
+type metadataResource struct {
+	client *defectdojo.APIClient
+}
+
+type metadataResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	TargetType types.String `tfsdk:"target_type"`
+	TargetID   types.Int64  `tfsdk:"target_id"`
+	Name       types.String `tfsdk:"name"`
+	Value      types.String `tfsdk:"value"`
+}
+
+// Metadata returns the resource type name.
+func (r *metadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metadata"
+}
+
+// Schema defines the schema for the resource.
+func (r *metadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the metadata",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Description: "The type of object the metadata is attached to. Valid values are: product, endpoint, finding",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(metadataTargetTypes...),
+				},
+			},
+			"target_id": schema.Int64Attribute{
+				Description: "The ID of the product, endpoint or finding the metadata is attached to",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the metadata entry. Must be unique per target",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the metadata entry",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *metadataResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *metadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan metadataResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	metadataRequest := metadataRequestFromPlan(plan)
+
+	// Create new metadata
+	metadata, res, err := r.client.MetadataAPI.MetadataCreate(ctx).MetaRequest(metadataRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Metadata",
+			"Could not create metadata, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(metadata.GetId()))
+	mapMetadataToModel(metadata, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *metadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state metadataResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed metadata value from Defectdojo
+	metadata, res, err := r.client.MetadataAPI.MetadataRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Metadata",
+			"Could not read metadata with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(metadata.GetId()))
+	mapMetadataToModel(metadata, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *metadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan metadataResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	metadataRequest := metadataRequestFromPlan(plan)
+
+	// Update existing metadata
+	_, res, err := r.client.MetadataAPI.MetadataUpdate(ctx, int32(plan.ID.ValueInt64())).MetaRequest(metadataRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Metadata",
+			"Could not update metadata with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed metadata value from Defectdojo
+	metadata, res, err := r.client.MetadataAPI.MetadataRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Metadata",
+			"Could not read metadata with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(metadata.GetId()))
+	mapMetadataToModel(metadata, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *metadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state metadataResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing metadata
+	res, err := r.client.MetadataAPI.MetadataDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Metadata",
+			"Could not delete metadata, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *metadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// metadataTargetTypes are the objects metadata can be attached to.
+var metadataTargetTypes = []string{"product", "endpoint", "finding"}
+
+// metadataRequestFromPlan generates the request for creating and updating a metadata entry.
+func metadataRequestFromPlan(plan metadataResourceModel) defectdojo.MetaRequest {
+	metadataRequest := defectdojo.MetaRequest{
+		Name:  plan.Name.ValueString(),
+		Value: plan.Value.ValueString(),
+	}
+
+	targetID := int32(plan.TargetID.ValueInt64())
+	switch plan.TargetType.ValueString() {
+	case "product":
+		metadataRequest.Product = *defectdojo.NewNullableInt32(&targetID)
+	case "endpoint":
+		metadataRequest.Endpoint = *defectdojo.NewNullableInt32(&targetID)
+	case "finding":
+		metadataRequest.Finding = *defectdojo.NewNullableInt32(&targetID)
+	}
+
+	return metadataRequest
+}
+
+// mapMetadataToModel maps a metadata entry to the model.
+func mapMetadataToModel(metadata *defectdojo.Meta, model *metadataResourceModel) {
+	switch {
+	case metadata.Product.Get() != nil:
+		model.TargetType = types.StringValue("product")
+		model.TargetID = types.Int64Value(int64(*metadata.Product.Get()))
+	case metadata.Endpoint.Get() != nil:
+		model.TargetType = types.StringValue("endpoint")
+		model.TargetID = types.Int64Value(int64(*metadata.Endpoint.Get()))
+	case metadata.Finding.Get() != nil:
+		model.TargetType = types.StringValue("finding")
+		model.TargetID = types.Int64Value(int64(*metadata.Finding.Get()))
+	}
+	model.Name = types.StringValue(metadata.GetName())
+	model.Value = types.StringValue(metadata.GetValue())
+}
+
+// listProductMetadata returns all metadata entries attached to a product.
+func listProductMetadata(ctx context.Context, client *defectdojo.APIClient, productID int32) ([]defectdojo.Meta, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	entries, res, err := listAllPages(func(offset int32) ([]defectdojo.Meta, bool, *http.Response, error) {
+		metadataList, res, err := client.MetadataAPI.MetadataList(ctx).Product(productID).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return metadataList.Results, metadataList.Next.Get() != nil, res, nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Metadata",
+			"Could not read metadata of product "+strconv.Itoa(int(productID))+", unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return nil, diags
+	}
+
+	return entries, diags
+}
+
+// productMetadataFromAPI returns the metadata entries of a product as a map of name to value.
+func productMetadataFromAPI(ctx context.Context, client *defectdojo.APIClient, productID int32) (types.Map, diag.Diagnostics) {
+	entries, diags := listProductMetadata(ctx, client, productID)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	metadata := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		metadata[entry.GetName()] = entry.GetValue()
+	}
+
+	metadataValue, d := types.MapValueFrom(ctx, types.StringType, metadata)
+	diags.Append(d...)
+	return metadataValue, diags
+}
+
+// syncProductMetadata creates, updates and deletes the metadata entries of a product so that they match the given map.
+func syncProductMetadata(ctx context.Context, client *defectdojo.APIClient, productID int32, metadata types.Map) diag.Diagnostics {
+	desired := make(map[string]string)
+	diags := metadata.ElementsAs(ctx, &desired, true)
+	if diags.HasError() {
+		return diags
+	}
+
+	entries, d := listProductMetadata(ctx, client, productID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, entry := range entries {
+		value, ok := desired[entry.GetName()]
+		delete(desired, entry.GetName())
+
+		if !ok {
+			res, err := client.MetadataAPI.MetadataDestroy(ctx, entry.GetId()).Execute()
+			if err != nil {
+				diags.AddError(
+					"Error Deleting Defectdojo Metadata",
+					"Could not delete metadata "+entry.GetName()+", unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+				)
+				return diags
+			}
+			continue
+		}
+
+		if value == entry.GetValue() {
+			continue
+		}
+
+		metadataRequest := defectdojo.MetaRequest{
+			Product: *defectdojo.NewNullableInt32(&productID),
+			Name:    entry.GetName(),
+			Value:   value,
+		}
+		_, res, err := client.MetadataAPI.MetadataUpdate(ctx, entry.GetId()).MetaRequest(metadataRequest).Execute()
+		if err != nil {
+			diags.AddError(
+				"Error Updating Defectdojo Metadata",
+				"Could not update metadata "+entry.GetName()+", unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+			)
+			return diags
+		}
+	}
+
+	for name, value := range desired {
+		metadataRequest := defectdojo.MetaRequest{
+			Product: *defectdojo.NewNullableInt32(&productID),
+			Name:    name,
+			Value:   value,
+		}
+		_, res, err := client.MetadataAPI.MetadataCreate(ctx).MetaRequest(metadataRequest).Execute()
+		if err != nil {
+			diags.AddError(
+				"Error Creating Defectdojo Metadata",
+				"Could not create metadata "+name+", unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
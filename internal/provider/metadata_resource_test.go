@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccMetadataDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+`
+
+func TestAccMetadataResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccMetadataDependencies + `
+				resource "defectdojo_metadata" "test" {
+					target_type = "product"
+					target_id   = defectdojo_product.test_product.id
+					name        = "owner"
+					value       = "team-a"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_metadata.test", "target_type", "product"),
+					resource.TestCheckResourceAttrPair("defectdojo_metadata.test", "target_id", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttr("defectdojo_metadata.test", "name", "owner"),
+					resource.TestCheckResourceAttr("defectdojo_metadata.test", "value", "team-a"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_metadata.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_metadata.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccMetadataDependencies + `
+				resource "defectdojo_metadata" "test" {
+					target_type = "product"
+					target_id   = defectdojo_product.test_product.id
+					name        = "owner"
+					value       = "team-b"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_metadata.test", "value", "team-b"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMetadataResourceInvalidTargetType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "defectdojo_metadata" "test" {
+					target_type = "engagement"
+					target_id   = 1
+					name        = "owner"
+					value       = "team-a"
+				}
+			`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
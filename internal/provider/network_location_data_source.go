@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &NetworkLocationDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworkLocationDataSource{}
+)
+
+func NewNetworkLocationDataSource() datasource.DataSource {
+	return &NetworkLocationDataSource{}
+}
+
+// NetworkLocationDataSource defines the data source implementation.
+type NetworkLocationDataSource struct {
+	client *defectdojo.APIClient
+}
+
+// NetworkLocationDataSourceModel describes the data source data model.
+type NetworkLocationDataSourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Location types.String `tfsdk:"location"`
+}
+
+// Metadata returns the data source type name.
+func (d *NetworkLocationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_location"
+}
+
+// Schema defines the schema for the data source.
+func (d *NetworkLocationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the network location",
+				Computed:    true,
+			},
+			"location": schema.StringAttribute{
+				Description: "The location of the network to look up, e.g. 10.0.0.0/16",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *NetworkLocationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *NetworkLocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworkLocationDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkLocation, res, err := findInPages(func(offset int32) ([]defectdojo.NetworkLocation, bool, *http.Response, error) {
+		networkLocations, res, err := d.client.NetworkLocationsAPI.NetworkLocationsList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return networkLocations.Results, networkLocations.Next.Get() != nil, res, nil
+	}, func(networkLocation *defectdojo.NetworkLocation) bool {
+		return networkLocation.GetLocation() == state.Location.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Network Locations",
+			"Could not read network locations, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	if networkLocation == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("location"),
+			"Network Location Not Found",
+			"Could not find a network location with the location "+state.Location.String(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.Int64Value(int64(networkLocation.GetId()))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkLocationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_network_location" "test" {
+					location = "192.168.0.0/24"
+				}
+
+				data "defectdojo_network_location" "test" {
+					location = defectdojo_network_location.test.location
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the network location was found by its location
+					resource.TestCheckResourceAttrPair("data.defectdojo_network_location.test", "id", "defectdojo_network_location.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &noteResource{}
+	_ resource.ResourceWithConfigure   = &noteResource{}
+	_ resource.ResourceWithImportState = &noteResource{}
+	_ resource.ResourceWithModifyPlan  = &noteResource{}
+)
+
+// NewNoteResource is a helper function to simplify the provider implementation.
+func NewNoteResource() resource.Resource {
+	return &noteResource{}
+}
+
+// noteResource is the resource implementation.
+type noteResource struct {
+	client *defectdojo.APIClient
+}
+
+type noteResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	TargetType types.String `tfsdk:"target_type"`
+	TargetID   types.Int64  `tfsdk:"target_id"`
+	Entry      types.String `tfsdk:"entry"`
+	NoteType   types.Int64  `tfsdk:"note_type"`
+	Private    types.Bool   `tfsdk:"private"`
+}
+
+// Metadata returns the resource type name.
+func (r *noteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_note"
+}
+
+// Schema defines the schema for the resource.
+func (r *noteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a note on an engagement, test or finding. Defectdojo only supports removing notes from findings, so destroying or replacing a note of an engagement or test only removes it from the Terraform state and leaves the note in Defectdojo. Existing notes can be imported with an ID in the format <target_type>/<target_id>/<id>",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the note",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Description: "The type of object the note is attached to. Valid values are: engagement, test, finding",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(noteTargetTypes...),
+				},
+			},
+			"target_id": schema.Int64Attribute{
+				Description: "The ID of the engagement, test or finding the note is attached to",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"entry": schema.StringAttribute{
+				Description: "The text of the note",
+				Required:    true,
+			},
+			"note_type": schema.Int64Attribute{
+				Description: "The ID of the note type",
+				Optional:    true,
+			},
+			"private": schema.BoolAttribute{
+				Description: "Whether the note is private",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *noteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *noteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan noteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	noteRequest := defectdojo.AddNewNoteOptionRequest{
+		Entry:   plan.Entry.ValueString(),
+		Private: plan.Private.ValueBoolPointer(),
+	}
+	if !plan.NoteType.IsNull() {
+		noteType := int32(plan.NoteType.ValueInt64())
+		noteRequest.NoteType = *defectdojo.NewNullableInt32(&noteType)
+	}
+
+	// Create new note on the target via its notes endpoint
+	var note *defectdojo.Note
+	var res *http.Response
+	var err error
+	targetID := int32(plan.TargetID.ValueInt64())
+	switch plan.TargetType.ValueString() {
+	case "engagement":
+		note, res, err = r.client.EngagementsAPI.EngagementsNotesCreate(ctx, targetID).AddNewNoteOptionRequest(noteRequest).Execute()
+	case "test":
+		note, res, err = r.client.TestsAPI.TestsNotesCreate(ctx, targetID).AddNewNoteOptionRequest(noteRequest).Execute()
+	case "finding":
+		note, res, err = r.client.FindingsAPI.FindingsNotesCreate(ctx, targetID).AddNewNoteOptionRequest(noteRequest).Execute()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Note",
+			"Could not create note, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(note.GetId()))
+	mapNoteToModel(note, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *noteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state noteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed note value from Defectdojo
+	note, res, err := r.client.NotesAPI.NotesRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Note",
+			"Could not read note with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(note.GetId()))
+	mapNoteToModel(note, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *noteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan noteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	edited := true
+	noteRequest := defectdojo.NoteRequest{
+		Entry:   plan.Entry.ValueString(),
+		Private: plan.Private.ValueBoolPointer(),
+		Edited:  &edited,
+	}
+	if plan.NoteType.IsNull() {
+		noteRequest.NoteType = *defectdojo.NewNullableInt32(nil)
+	} else {
+		noteType := int32(plan.NoteType.ValueInt64())
+		noteRequest.NoteType = *defectdojo.NewNullableInt32(&noteType)
+	}
+
+	// Update existing note
+	_, res, err := r.client.NotesAPI.NotesUpdate(ctx, int32(plan.ID.ValueInt64())).NoteRequest(noteRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Note",
+			"Could not update note with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed note value from Defectdojo
+	note, res, err := r.client.NotesAPI.NotesRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Note",
+			"Could not read note with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(note.GetId()))
+	mapNoteToModel(note, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan warns when a note of an engagement or test would be destroyed or replaced,
+// since Defectdojo keeps those notes.
+func (r *noteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to warn about when the note is created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state noteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TargetType.ValueString() == "finding" {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Defectdojo Note Will Not Be Deleted",
+			"Defectdojo doesn't support deleting notes of engagements and tests. Destroying the note with ID "+state.ID.String()+" only removes it from the Terraform state.",
+		)
+		return
+	}
+
+	var plan noteResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TargetType.Equal(state.TargetType) || !plan.TargetID.Equal(state.TargetID) {
+		resp.Diagnostics.AddWarning(
+			"Defectdojo Note Will Not Be Deleted",
+			"Defectdojo doesn't support deleting notes of engagements and tests. Moving the note with ID "+state.ID.String()+" creates a new note and leaves the existing one on "+state.TargetType.ValueString()+" "+state.TargetID.String()+".",
+		)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *noteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state noteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defectdojo only allows removing notes from findings, notes of engagements and tests are kept
+	if state.TargetType.ValueString() != "finding" {
+		resp.Diagnostics.AddWarning(
+			"Defectdojo Note Not Deleted",
+			"Defectdojo doesn't support deleting notes of engagements and tests. The note with ID "+state.ID.String()+" was removed from the Terraform state but still exists on "+state.TargetType.ValueString()+" "+state.TargetID.String()+".",
+		)
+		return
+	}
+
+	// Remove existing note from the finding
+	noteID := int32(state.ID.ValueInt64())
+	findingNoteRequest := defectdojo.PatchedFindingNoteRequest{
+		NoteId: &noteID,
+	}
+	res, err := r.client.FindingsAPI.FindingsRemoveNotePartialUpdate(ctx, int32(state.TargetID.ValueInt64())).PatchedFindingNoteRequest(findingNoteRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Note",
+			"Could not delete note, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *noteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// notes don't reference the object they are attached to, so the import ID has to contain it
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || !slices.Contains(noteTargetTypes, parts[0]) {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Expected import ID in the format <target_type>/<target_id>/<id>, e.g. engagement/1/2, got: "+req.ID,
+		)
+		return
+	}
+
+	targetID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert target ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_type"), types.StringValue(parts[0]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_id"), types.Int64Value(int64(targetID)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// noteTargetTypes are the objects notes can be attached to.
+var noteTargetTypes = []string{"engagement", "test", "finding"}
+
+// mapNoteToModel maps a note to the model.
+func mapNoteToModel(note *defectdojo.Note, model *noteResourceModel) {
+	model.Entry = types.StringValue(note.GetEntry())
+	model.Private = types.BoolValue(note.GetPrivate())
+	if noteType, ok := note.GetNoteTypeOk(); ok && noteType != nil {
+		model.NoteType = types.Int64Value(int64(noteType.GetId()))
+	} else {
+		model.NoteType = types.Int64Null()
+	}
+}
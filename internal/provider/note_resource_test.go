@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccNoteDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_engagement" "test_engagement" {
+	name         = "Test Engagement"
+	product      = defectdojo_product.test_product.id
+	target_start = "2024-01-01"
+	target_end   = "2024-01-31"
+}
+
+resource "defectdojo_note_type" "test_note_type" {
+	name         = "Test Note Type"
+	description  = "This is the description of the Test Note Type"
+	is_mandatory = false
+}
+`
+
+func TestAccNoteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNoteDependencies + `
+				resource "defectdojo_note" "test" {
+					target_type = "engagement"
+					target_id   = defectdojo_engagement.test_engagement.id
+					entry       = "Scope extended to the public API"
+					note_type   = defectdojo_note_type.test_note_type.id
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_note.test", "target_type", "engagement"),
+					resource.TestCheckResourceAttrPair("defectdojo_note.test", "target_id", "defectdojo_engagement.test_engagement", "id"),
+					resource.TestCheckResourceAttr("defectdojo_note.test", "entry", "Scope extended to the public API"),
+					resource.TestCheckResourceAttrPair("defectdojo_note.test", "note_type", "defectdojo_note_type.test_note_type", "id"),
+					resource.TestCheckResourceAttr("defectdojo_note.test", "private", "false"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_note.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_note.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["defectdojo_note.test"]
+					return fmt.Sprintf("engagement/%s/%s", rs.Primary.Attributes["target_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNoteDependencies + `
+				resource "defectdojo_note" "test" {
+					target_type = "engagement"
+					target_id   = defectdojo_engagement.test_engagement.id
+					entry       = "Scope extended to the public API and the admin UI"
+					note_type   = defectdojo_note_type.test_note_type.id
+					private     = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_note.test", "entry", "Scope extended to the public API and the admin UI"),
+					resource.TestCheckResourceAttr("defectdojo_note.test", "private", "true"),
+				),
+			},
+			// Update note type in place
+			{
+				Config: providerConfig + testAccNoteDependencies + `
+				resource "defectdojo_note" "test" {
+					target_type = "engagement"
+					target_id   = defectdojo_engagement.test_engagement.id
+					entry       = "Scope extended to the public API and the admin UI"
+					private     = true
+				}
+			`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("defectdojo_note.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("defectdojo_note.test", "note_type"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &noteTypeResource{}
+	_ resource.ResourceWithConfigure   = &noteTypeResource{}
+	_ resource.ResourceWithImportState = &noteTypeResource{}
+)
+
+// NewNoteTypeResource is a helper function to simplify the provider implementation.
+func NewNoteTypeResource() resource.Resource {
+	return &noteTypeResource{}
+}
+
+// noteTypeResource is the resource implementation.
+type noteTypeResource struct {
+	client *defectdojo.APIClient
+}
+
+type noteTypeResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsSingle    types.Bool   `tfsdk:"is_single"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	IsMandatory types.Bool   `tfsdk:"is_mandatory"`
+}
+
+// Metadata returns the resource type name.
+func (r *noteTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_note_type"
+}
+
+// Schema defines the schema for the resource.
+func (r *noteTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the note type",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the note type",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the note type",
+				Required:    true,
+			},
+			"is_single": schema.BoolAttribute{
+				Description: "Whether only a single note of this type can be added to an object",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the note type can be selected for new notes",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"is_mandatory": schema.BoolAttribute{
+				Description: "Whether a note of this type is required, e.g. before closing an engagement",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *noteTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *noteTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan noteTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	noteTypeRequest := noteTypeRequestFromPlan(plan)
+
+	// Create new note type
+	noteType, res, err := r.client.NoteTypeAPI.NoteTypeCreate(ctx).NoteTypeRequest(noteTypeRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Note Type",
+			"Could not create note type, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(noteType.GetId()))
+	mapNoteTypeToModel(noteType, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *noteTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state noteTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed note type value from Defectdojo
+	noteType, res, err := r.client.NoteTypeAPI.NoteTypeRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Note Type",
+			"Could not read note type with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(noteType.GetId()))
+	mapNoteTypeToModel(noteType, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *noteTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan noteTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	noteTypeRequest := noteTypeRequestFromPlan(plan)
+
+	// Update existing note type
+	_, res, err := r.client.NoteTypeAPI.NoteTypeUpdate(ctx, int32(plan.ID.ValueInt64())).NoteTypeRequest(noteTypeRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Note Type",
+			"Could not update note type with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed note type value from Defectdojo
+	noteType, res, err := r.client.NoteTypeAPI.NoteTypeRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Note Type",
+			"Could not read note type with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(noteType.GetId()))
+	mapNoteTypeToModel(noteType, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *noteTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state noteTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing note type
+	res, err := r.client.NoteTypeAPI.NoteTypeDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Note Type",
+			"Could not delete note type, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *noteTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// noteTypeRequestFromPlan generates the request for creating and updating a note type.
+func noteTypeRequestFromPlan(plan noteTypeResourceModel) defectdojo.NoteTypeRequest {
+	return defectdojo.NoteTypeRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IsSingle:    plan.IsSingle.ValueBoolPointer(),
+		IsActive:    plan.IsActive.ValueBoolPointer(),
+		IsMandatory: plan.IsMandatory.ValueBoolPointer(),
+	}
+}
+
+// mapNoteTypeToModel maps a note type to the model.
+func mapNoteTypeToModel(noteType *defectdojo.NoteType, model *noteTypeResourceModel) {
+	model.Name = types.StringValue(noteType.GetName())
+	model.Description = types.StringValue(noteType.GetDescription())
+	model.IsSingle = types.BoolValue(noteType.GetIsSingle())
+	model.IsActive = types.BoolValue(noteType.GetIsActive())
+	model.IsMandatory = types.BoolValue(noteType.GetIsMandatory())
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNoteTypeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_note_type" "test" {
+					name        = "Test Note Type"
+					description = "This is the description of the Test Note Type"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "name", "Test Note Type"),
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "description", "This is the description of the Test Note Type"),
+
+					// Verify default values
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "is_single", "false"),
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "is_mandatory", "true"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_note_type.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_note_type.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_note_type" "test" {
+					name         = "Test Note Type"
+					description  = "This is the updated description of the Test Note Type"
+					is_single    = true
+					is_mandatory = false
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "description", "This is the updated description of the Test Note Type"),
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "is_single", "true"),
+					resource.TestCheckResourceAttr("defectdojo_note_type.test", "is_mandatory", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,601 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &notificationsResource{}
+	_ resource.ResourceWithConfigure   = &notificationsResource{}
+	_ resource.ResourceWithImportState = &notificationsResource{}
+)
+
+// notificationChannels are the channels a notification can be sent to.
+var notificationChannels = []string{"alert", "mail", "msteams", "slack", "webhooks"}
+
+// notificationsCreatedKey marks notification settings created by the resource in the private state.
+const notificationsCreatedKey = "created"
+
+// NewNotificationsResource is a helper function to simplify the provider implementation.
+func NewNotificationsResource() resource.Resource {
+	return &notificationsResource{}
+}
+
+// notificationsResource is the resource implementation.
+type notificationsResource struct {
+	client *defectdojo.APIClient
+}
+
+type notificationsResourceModel struct {
+	ID                       types.Int64 `tfsdk:"id"`
+	User                     types.Int64 `tfsdk:"user"`
+	Product                  types.Int64 `tfsdk:"product"`
+	Template                 types.Bool  `tfsdk:"template"`
+	ProductTypeAdded         types.Set   `tfsdk:"product_type_added"`
+	ProductAdded             types.Set   `tfsdk:"product_added"`
+	EngagementAdded          types.Set   `tfsdk:"engagement_added"`
+	TestAdded                types.Set   `tfsdk:"test_added"`
+	ScanAdded                types.Set   `tfsdk:"scan_added"`
+	ScanAddedEmpty           types.Set   `tfsdk:"scan_added_empty"`
+	JiraUpdate               types.Set   `tfsdk:"jira_update"`
+	UpcomingEngagement       types.Set   `tfsdk:"upcoming_engagement"`
+	StaleEngagement          types.Set   `tfsdk:"stale_engagement"`
+	AutoCloseEngagement      types.Set   `tfsdk:"auto_close_engagement"`
+	CloseEngagement          types.Set   `tfsdk:"close_engagement"`
+	UserMentioned            types.Set   `tfsdk:"user_mentioned"`
+	CodeReview               types.Set   `tfsdk:"code_review"`
+	ReviewRequested          types.Set   `tfsdk:"review_requested"`
+	Other                    types.Set   `tfsdk:"other"`
+	SLABreach                types.Set   `tfsdk:"sla_breach"`
+	SLABreachCombined        types.Set   `tfsdk:"sla_breach_combined"`
+	RiskAcceptanceExpiration types.Set   `tfsdk:"risk_acceptance_expiration"`
+}
+
+// Metadata returns the resource type name.
+func (r *notificationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications"
+}
+
+// Schema defines the schema for the resource.
+func (r *notificationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the notification settings of Defectdojo. Without user and template the system-wide notifications are managed. Existing notification settings for the same target are adopted on creation. Destroying this resource only deletes notification settings it created, adopted, imported and system-wide notification settings are left in place with their current values",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the notification settings",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.Int64Attribute{
+				Description: "The ID of the user the notification settings apply to",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"product": schema.Int64Attribute{
+				Description: "The ID of the product the notification settings of the user apply to, requires user",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("user")),
+				},
+			},
+			"template": schema.BoolAttribute{
+				Description: "Whether the notification settings are the template for new users, conflicts with user",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("user")),
+				},
+			},
+			"product_type_added": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a product type is added",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"product_added": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a product is added",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"engagement_added": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when an engagement is added",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"test_added": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a test is added",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"scan_added": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a scan is imported",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"scan_added_empty": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a scan without findings is imported",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"jira_update": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a JIRA issue is updated",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"upcoming_engagement": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when an engagement is about to start",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"stale_engagement": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when an engagement is past its end date",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"auto_close_engagement": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a stale engagement is closed automatically",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"close_engagement": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when an engagement is closed",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"user_mentioned": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a user is mentioned in a note",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"code_review": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a code review is requested",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"review_requested": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a review of a finding is requested",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"other": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when any other event occurs",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"sla_breach": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a finding breaches or is about to breach its SLA",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"sla_breach_combined": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when findings breach or are about to breach their SLA, combined into a single notification",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+			"risk_acceptance_expiration": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The channels notified when a risk acceptance expires or is about to expire",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationChannels...)),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *notificationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *notificationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan notificationsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	notificationsRequest, diags := notificationsRequestFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defectdojo creates the system-wide and user notification settings on demand,
+	// so existing notification settings for the same target are adopted
+	existing, diags := r.findNotifications(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var notifications *defectdojo.Notifications
+	var res *http.Response
+	var err error
+	if existing != nil {
+		notifications, res, err = r.client.NotificationsAPI.NotificationsUpdate(ctx, existing.GetId()).NotificationsRequest(notificationsRequest).Execute()
+	} else {
+		notifications, res, err = r.client.NotificationsAPI.NotificationsCreate(ctx).NotificationsRequest(notificationsRequest).Execute()
+
+		// remember that the notification settings did not exist before, so Delete may remove them
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, notificationsCreatedKey, []byte("true"))...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Notifications",
+			"Could not create notifications, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(notifications.GetId()))
+	resp.Diagnostics.Append(mapNotificationsToModel(ctx, notifications, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *notificationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state notificationsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed notifications value from Defectdojo
+	notifications, res, err := r.client.NotificationsAPI.NotificationsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Notifications",
+			"Could not read notifications with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(notifications.GetId()))
+	resp.Diagnostics.Append(mapNotificationsToModel(ctx, notifications, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *notificationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan notificationsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	notificationsRequest, diags := notificationsRequestFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing notifications
+	notifications, res, err := r.client.NotificationsAPI.NotificationsUpdate(ctx, int32(plan.ID.ValueInt64())).NotificationsRequest(notificationsRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Notifications",
+			"Could not update notifications with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(notifications.GetId()))
+	resp.Diagnostics.Append(mapNotificationsToModel(ctx, notifications, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes notification settings created by the resource and removes the Terraform state on success.
+func (r *notificationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state notificationsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// adopted, imported and system-wide notification settings existed before Terraform
+	// managed them, so they are left in place
+	created, diags := req.Private.GetKey(ctx, notificationsCreatedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	systemWide := state.User.IsNull() && state.Product.IsNull() && !state.Template.ValueBool()
+	if created == nil || systemWide {
+		return
+	}
+
+	// Delete existing notifications
+	res, err := r.client.NotificationsAPI.NotificationsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Notifications",
+			"Could not delete notifications, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *notificationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// findNotifications looks up the notification settings for the target of the plan.
+// it returns nil if there are none.
+func (r *notificationsResource) findNotifications(ctx context.Context, plan notificationsResourceModel) (*defectdojo.Notifications, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// the API can't filter for notifications without a user or product, so we have to go through all pages
+	notifications, res, err := findInPages(func(offset int32) ([]defectdojo.Notifications, bool, *http.Response, error) {
+		notificationsList, res, err := r.client.NotificationsAPI.NotificationsList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return notificationsList.Results, notificationsList.Next.Get() != nil, res, nil
+	}, func(notifications *defectdojo.Notifications) bool {
+		return defectdojoNullableInt32ToBasetypesInt64Value(notifications.User).Equal(plan.User) &&
+			defectdojoNullableInt32ToBasetypesInt64Value(notifications.Product).Equal(plan.Product) &&
+			notifications.GetTemplate() == plan.Template.ValueBool()
+	})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Notifications",
+			"Could not read notifications, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return nil, diags
+	}
+
+	return notifications, diags
+}
+
+// notificationsRequestFromPlan generates the request for creating and updating notifications.
+// unknown channels are left out, so Defectdojo keeps its defaults.
+func notificationsRequestFromPlan(ctx context.Context, plan notificationsResourceModel) (defectdojo.NotificationsRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	notificationsRequest := defectdojo.NotificationsRequest{
+		User:                     basetypesInt64ValueToDefectdojoNullableInt32(plan.User),
+		Product:                  basetypesInt64ValueToDefectdojoNullableInt32(plan.Product),
+		Template:                 basetypesBoolValueToBoolPointer(plan.Template),
+		ProductTypeAdded:         notificationChannelsFromSet(ctx, plan.ProductTypeAdded, &diags),
+		ProductAdded:             notificationChannelsFromSet(ctx, plan.ProductAdded, &diags),
+		EngagementAdded:          notificationChannelsFromSet(ctx, plan.EngagementAdded, &diags),
+		TestAdded:                notificationChannelsFromSet(ctx, plan.TestAdded, &diags),
+		ScanAdded:                notificationChannelsFromSet(ctx, plan.ScanAdded, &diags),
+		ScanAddedEmpty:           notificationChannelsFromSet(ctx, plan.ScanAddedEmpty, &diags),
+		JiraUpdate:               notificationChannelsFromSet(ctx, plan.JiraUpdate, &diags),
+		UpcomingEngagement:       notificationChannelsFromSet(ctx, plan.UpcomingEngagement, &diags),
+		StaleEngagement:          notificationChannelsFromSet(ctx, plan.StaleEngagement, &diags),
+		AutoCloseEngagement:      notificationChannelsFromSet(ctx, plan.AutoCloseEngagement, &diags),
+		CloseEngagement:          notificationChannelsFromSet(ctx, plan.CloseEngagement, &diags),
+		UserMentioned:            notificationChannelsFromSet(ctx, plan.UserMentioned, &diags),
+		CodeReview:               notificationChannelsFromSet(ctx, plan.CodeReview, &diags),
+		ReviewRequested:          notificationChannelsFromSet(ctx, plan.ReviewRequested, &diags),
+		Other:                    notificationChannelsFromSet(ctx, plan.Other, &diags),
+		SlaBreach:                notificationChannelsFromSet(ctx, plan.SLABreach, &diags),
+		SlaBreachCombined:        notificationChannelsFromSet(ctx, plan.SLABreachCombined, &diags),
+		RiskAcceptanceExpiration: notificationChannelsFromSet(ctx, plan.RiskAcceptanceExpiration, &diags),
+	}
+
+	return notificationsRequest, diags
+}
+
+// mapNotificationsToModel maps the notifications to the model.
+func mapNotificationsToModel(ctx context.Context, notifications *defectdojo.Notifications, model *notificationsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.User = defectdojoNullableInt32ToBasetypesInt64Value(notifications.User)
+	model.Product = defectdojoNullableInt32ToBasetypesInt64Value(notifications.Product)
+	model.Template = boolPointerToBasetypesBoolValue(notifications.Template)
+	model.ProductTypeAdded = notificationChannelsToSet(ctx, notifications.ProductTypeAdded, &diags)
+	model.ProductAdded = notificationChannelsToSet(ctx, notifications.ProductAdded, &diags)
+	model.EngagementAdded = notificationChannelsToSet(ctx, notifications.EngagementAdded, &diags)
+	model.TestAdded = notificationChannelsToSet(ctx, notifications.TestAdded, &diags)
+	model.ScanAdded = notificationChannelsToSet(ctx, notifications.ScanAdded, &diags)
+	model.ScanAddedEmpty = notificationChannelsToSet(ctx, notifications.ScanAddedEmpty, &diags)
+	model.JiraUpdate = notificationChannelsToSet(ctx, notifications.JiraUpdate, &diags)
+	model.UpcomingEngagement = notificationChannelsToSet(ctx, notifications.UpcomingEngagement, &diags)
+	model.StaleEngagement = notificationChannelsToSet(ctx, notifications.StaleEngagement, &diags)
+	model.AutoCloseEngagement = notificationChannelsToSet(ctx, notifications.AutoCloseEngagement, &diags)
+	model.CloseEngagement = notificationChannelsToSet(ctx, notifications.CloseEngagement, &diags)
+	model.UserMentioned = notificationChannelsToSet(ctx, notifications.UserMentioned, &diags)
+	model.CodeReview = notificationChannelsToSet(ctx, notifications.CodeReview, &diags)
+	model.ReviewRequested = notificationChannelsToSet(ctx, notifications.ReviewRequested, &diags)
+	model.Other = notificationChannelsToSet(ctx, notifications.Other, &diags)
+	model.SLABreach = notificationChannelsToSet(ctx, notifications.SlaBreach, &diags)
+	model.SLABreachCombined = notificationChannelsToSet(ctx, notifications.SlaBreachCombined, &diags)
+	model.RiskAcceptanceExpiration = notificationChannelsToSet(ctx, notifications.RiskAcceptanceExpiration, &diags)
+
+	return diags
+}
+
+// notificationChannelsFromSet converts a set of channels to the list expected by Defectdojo.
+// an unknown set results in nil, which leaves the channels out of the request.
+func notificationChannelsFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	channels := make([]string, 0)
+	diags.Append(set.ElementsAs(ctx, &channels, false)...)
+	return channels
+}
+
+// notificationChannelsToSet converts the channels returned by Defectdojo to a set.
+func notificationChannelsToSet(ctx context.Context, channels []string, diags *diag.Diagnostics) types.Set {
+	if channels == nil {
+		channels = []string{}
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, channels)
+	diags.Append(d...)
+	return set
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccNotificationsDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_user" "test_user" {
+	username = "notifications-test-user"
+	email    = "notifications-test-user@example.com"
+}
+`
+
+func TestAccNotificationsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNotificationsDependencies + `
+				resource "defectdojo_notifications" "test" {
+					user       = defectdojo_user.test_user.id
+					product    = defectdojo_product.test_product.id
+					scan_added = ["alert", "mail"]
+					sla_breach = ["slack"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttrPair("defectdojo_notifications.test", "user", "defectdojo_user.test_user", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_notifications.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttr("defectdojo_notifications.test", "template", "false"),
+					resource.TestCheckResourceAttr("defectdojo_notifications.test", "scan_added.#", "2"),
+					resource.TestCheckTypeSetElemAttr("defectdojo_notifications.test", "scan_added.*", "alert"),
+					resource.TestCheckTypeSetElemAttr("defectdojo_notifications.test", "scan_added.*", "mail"),
+					resource.TestCheckResourceAttr("defectdojo_notifications.test", "sla_breach.#", "1"),
+					resource.TestCheckTypeSetElemAttr("defectdojo_notifications.test", "sla_breach.*", "slack"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_notifications.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_notifications.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNotificationsDependencies + `
+				resource "defectdojo_notifications" "test" {
+					user             = defectdojo_user.test_user.id
+					product          = defectdojo_product.test_product.id
+					scan_added       = []
+					sla_breach       = ["slack", "msteams"]
+					engagement_added = ["alert"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_notifications.test", "scan_added.#", "0"),
+					resource.TestCheckResourceAttr("defectdojo_notifications.test", "sla_breach.#", "2"),
+					resource.TestCheckTypeSetElemAttr("defectdojo_notifications.test", "sla_breach.*", "msteams"),
+					resource.TestCheckResourceAttr("defectdojo_notifications.test", "engagement_added.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNotificationsResourceSystemWideKeptOnDestroy(t *testing.T) {
+	var systemWideID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt the system-wide notification settings
+			{
+				Config: providerConfig + `
+				resource "defectdojo_notifications" "system" {
+					product_added = ["alert"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("defectdojo_notifications.system", "id", func(value string) error {
+						systemWideID = value
+						return nil
+					}),
+				),
+			},
+			// Destroy the resource
+			{
+				Config: providerConfig,
+			},
+			// Adopting them again returns the same notification settings, so they were not deleted
+			{
+				Config: providerConfig + `
+				resource "defectdojo_notifications" "system" {
+					product_added = ["alert"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("defectdojo_notifications.system", "id", func(value string) error {
+						if value != systemWideID {
+							return fmt.Errorf("expected the system-wide notification settings %s to be adopted again, got %s", systemWideID, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
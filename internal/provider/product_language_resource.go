@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &productLanguageResource{}
+	_ resource.ResourceWithConfigure   = &productLanguageResource{}
+	_ resource.ResourceWithImportState = &productLanguageResource{}
+)
+
+// NewProductLanguageResource is a helper function to simplify the provider implementation.
+func NewProductLanguageResource() resource.Resource {
+	return &productLanguageResource{}
+}
+
+// productLanguageResource is the resource implementation.
+type productLanguageResource struct {
+	client *defectdojo.APIClient
+}
+
+type productLanguageResourceModel struct {
+	ID       types.Int64 `tfsdk:"id"`
+	Product  types.Int64 `tfsdk:"product"`
+	Language types.Int64 `tfsdk:"language"`
+	Files    types.Int64 `tfsdk:"files"`
+	Code     types.Int64 `tfsdk:"code"`
+	Blank    types.Int64 `tfsdk:"blank"`
+	Comment  types.Int64 `tfsdk:"comment"`
+	User     types.Int64 `tfsdk:"user"`
+}
+
+// Metadata returns the resource type name.
+func (r *productLanguageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_language"
+}
+
+// Schema defines the schema for the resource.
+func (r *productLanguageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the product language",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"product": schema.Int64Attribute{
+				Description: "The ID of the product",
+				Required:    true,
+			},
+			"language": schema.Int64Attribute{
+				Description: "The ID of the language type, e.g. from the defectdojo_language_types data source",
+				Required:    true,
+			},
+			"files": schema.Int64Attribute{
+				Description: "The number of files in the language",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"code": schema.Int64Attribute{
+				Description: "The number of lines of code in the language",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"blank": schema.Int64Attribute{
+				Description: "The number of blank lines in the language",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"comment": schema.Int64Attribute{
+				Description: "The number of comment lines in the language",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"user": schema.Int64Attribute{
+				Description: "The ID of the user who added the language",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *productLanguageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *productLanguageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan productLanguageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	productLanguageRequest := productLanguageRequestFromPlan(plan)
+
+	// Create new product language
+	productLanguage, res, err := r.client.LanguagesAPI.LanguagesCreate(ctx).LanguagesRequest(productLanguageRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Product Language",
+			"Could not create product language, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(productLanguage.GetId()))
+	mapProductLanguageToModel(productLanguage, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *productLanguageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state productLanguageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed product language value from Defectdojo
+	productLanguage, res, err := r.client.LanguagesAPI.LanguagesRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Product Language",
+			"Could not read product language with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(productLanguage.GetId()))
+	mapProductLanguageToModel(productLanguage, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *productLanguageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan productLanguageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	productLanguageRequest := productLanguageRequestFromPlan(plan)
+
+	// Update existing product language
+	_, res, err := r.client.LanguagesAPI.LanguagesUpdate(ctx, int32(plan.ID.ValueInt64())).LanguagesRequest(productLanguageRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Product Language",
+			"Could not update product language with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed product language value from Defectdojo
+	productLanguage, res, err := r.client.LanguagesAPI.LanguagesRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Product Language",
+			"Could not read product language with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(productLanguage.GetId()))
+	mapProductLanguageToModel(productLanguage, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *productLanguageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state productLanguageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing product language
+	res, err := r.client.LanguagesAPI.LanguagesDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Product Language",
+			"Could not delete product language, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *productLanguageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// productLanguageRequestFromPlan generates the request for creating and updating a product language.
+func productLanguageRequestFromPlan(plan productLanguageResourceModel) defectdojo.LanguagesRequest {
+	return defectdojo.LanguagesRequest{
+		Product:  int32(plan.Product.ValueInt64()),
+		Language: int32(plan.Language.ValueInt64()),
+		Files:    basetypesInt64ValueToDefectdojoNullableInt32(plan.Files),
+		Code:     basetypesInt64ValueToDefectdojoNullableInt32(plan.Code),
+		Blank:    basetypesInt64ValueToDefectdojoNullableInt32(plan.Blank),
+		Comment:  basetypesInt64ValueToDefectdojoNullableInt32(plan.Comment),
+		User:     basetypesInt64ValueToDefectdojoNullableInt32(plan.User),
+	}
+}
+
+// mapProductLanguageToModel maps a product language to the model.
+func mapProductLanguageToModel(productLanguage *defectdojo.Languages, model *productLanguageResourceModel) {
+	model.Product = types.Int64Value(int64(productLanguage.GetProduct()))
+	model.Language = types.Int64Value(int64(productLanguage.GetLanguage()))
+	model.Files = defectdojoNullableInt32ToBasetypesInt64Value(productLanguage.Files)
+	model.Code = defectdojoNullableInt32ToBasetypesInt64Value(productLanguage.Code)
+	model.Blank = defectdojoNullableInt32ToBasetypesInt64Value(productLanguage.Blank)
+	model.Comment = defectdojoNullableInt32ToBasetypesInt64Value(productLanguage.Comment)
+	model.User = defectdojoNullableInt32ToBasetypesInt64Value(productLanguage.User)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccProductLanguageDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+data "defectdojo_language_types" "all" {}
+
+locals {
+	go = one([for language_type in data.defectdojo_language_types.all.language_types : language_type.id if language_type.language == "Go"])
+}
+`
+
+func TestAccProductLanguageResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccProductLanguageDependencies + `
+				resource "defectdojo_product_language" "test" {
+					product  = defectdojo_product.test_product.id
+					language = local.go
+					files    = 10
+					code     = 1000
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttrPair("defectdojo_product_language.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttr("defectdojo_product_language.test", "files", "10"),
+					resource.TestCheckResourceAttr("defectdojo_product_language.test", "code", "1000"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_product_language.test", "id"),
+					resource.TestCheckResourceAttrSet("defectdojo_product_language.test", "language"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_language.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccProductLanguageDependencies + `
+				resource "defectdojo_product_language" "test" {
+					product  = defectdojo_product.test_product.id
+					language = local.go
+					files    = 12
+					code     = 1200
+					blank    = 150
+					comment  = 200
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_product_language.test", "files", "12"),
+					resource.TestCheckResourceAttr("defectdojo_product_language.test", "code", "1200"),
+					resource.TestCheckResourceAttr("defectdojo_product_language.test", "blank", "150"),
+					resource.TestCheckResourceAttr("defectdojo_product_language.test", "comment", "200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -60,6 +61,7 @@ type productResourceModel struct {
 	SlaConfiguration              types.Int64  `tfsdk:"sla_configuration"`
 	Regulations                   types.List   `tfsdk:"regulations"`
 	Tags                          types.List   `tfsdk:"tags"`
+	Metadata                      types.Map    `tfsdk:"metadata"`
 }
 
 // Metadata returns the data source type name.
@@ -183,13 +185,13 @@ func (r *productResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:    true,
 			},
 			"sla_configuration": schema.Int64Attribute{
-				Description: "The SLA configuration of the product",
+				Description: "The ID of the SLA configuration of the product",
 				Computed:    true,
 				Optional:    true,
 			},
 			"regulations": schema.ListAttribute{
 				ElementType: types.Int64Type,
-				Description: "List of regulations for the product",
+				Description: "List of regulation IDs for the product, e.g. from the defectdojo_regulation data source",
 				Computed:    true,
 				Optional:    true,
 			},
@@ -199,6 +201,11 @@ func (r *productResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 				Optional:    true,
 			},
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Custom metadata of the product as a map of name to value. If set, all metadata entries of the product are managed by this attribute and entries not in the map are removed. Removing the attribute stops managing the entries and leaves them in place. Importing a product reads all of its metadata entries. Do not combine with defectdojo_metadata resources targeting the same product",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -209,17 +216,17 @@ func (r *productResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*defectdojo.APIClient)
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *defectdojo.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -267,18 +274,18 @@ func (r *productResource) Create(ctx context.Context, req resource.CreateRequest
 		Name:                          plan.Name.ValueString(),
 		Description:                   plan.Description.ValueString(),
 		ProdNumericGrade:              basetypesInt64ValueToDefectdojoNullableInt32(plan.ProdNumericGrade),
-		BusinessCriticality:           *defectdojo.NewNullableString(plan.BusinessCriticality.ValueStringPointer()),
-		Platform:                      *defectdojo.NewNullableString(plan.Platform.ValueStringPointer()),
-		Lifecycle:                     *defectdojo.NewNullableString(plan.Lifecycle.ValueStringPointer()),
-		Origin:                        *defectdojo.NewNullableString(plan.Origin.ValueStringPointer()),
+		BusinessCriticality:           basetypesStringValueToDefectdojoNullableString(plan.BusinessCriticality),
+		Platform:                      basetypesStringValueToDefectdojoNullableString(plan.Platform),
+		Lifecycle:                     basetypesStringValueToDefectdojoNullableString(plan.Lifecycle),
+		Origin:                        basetypesStringValueToDefectdojoNullableString(plan.Origin),
 		UserRecords:                   basetypesInt64ValueToDefectdojoNullableInt32(plan.UserRecords),
 		Revenue:                       *defectdojo.NewNullableFloat64(revenue),
-		ExternalAudience:              plan.ExternalAudience.ValueBoolPointer(),
-		InternetAccessible:            plan.InternetAccessible.ValueBoolPointer(),
-		EnableProductTagInheritance:   plan.EnableProductTagInheritance.ValueBoolPointer(),
-		EnableSimpleRiskAcceptance:    plan.EnableSimpleRiskAcceptance.ValueBoolPointer(),
-		EnableFullRiskAcceptance:      plan.EnableFullRiskAcceptance.ValueBoolPointer(),
-		DisableSlaBreachNotifications: plan.DisableSlaBreachNotifications.ValueBoolPointer(),
+		ExternalAudience:              basetypesBoolValueToBoolPointer(plan.ExternalAudience),
+		InternetAccessible:            basetypesBoolValueToBoolPointer(plan.InternetAccessible),
+		EnableProductTagInheritance:   basetypesBoolValueToBoolPointer(plan.EnableProductTagInheritance),
+		EnableSimpleRiskAcceptance:    basetypesBoolValueToBoolPointer(plan.EnableSimpleRiskAcceptance),
+		EnableFullRiskAcceptance:      basetypesBoolValueToBoolPointer(plan.EnableFullRiskAcceptance),
+		DisableSlaBreachNotifications: basetypesBoolValueToBoolPointer(plan.DisableSlaBreachNotifications),
 		ProductManager:                basetypesInt64ValueToDefectdojoNullableInt32(plan.ProductManager),
 		TechnicalContact:              basetypesInt64ValueToDefectdojoNullableInt32(plan.TechnicalContact),
 		TeamManager:                   basetypesInt64ValueToDefectdojoNullableInt32(plan.TeamManager),
@@ -300,24 +307,38 @@ func (r *productResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.ID = types.Int64Value(int64(product.GetId()))
 	plan.Name = types.StringValue(product.GetName())
 	plan.Description = types.StringValue(product.GetDescription())
-	plan.ProdNumericGrade = types.Int64Value(int64(product.GetProdNumericGrade()))
-	plan.BusinessCriticality = types.StringValue(product.GetBusinessCriticality())
-	plan.Platform = types.StringValue(product.GetPlatform())
-	plan.Lifecycle = types.StringValue(product.GetLifecycle())
-	plan.Origin = types.StringValue(product.GetOrigin())
-	plan.UserRecords = types.Int64Value(int64(product.GetUserRecords()))
-	plan.Revenue = types.StringValue(fmt.Sprintf("%f", product.GetRevenue()))
-	plan.ExternalAudience = types.BoolValue(product.GetExternalAudience())
-	plan.InternetAccessible = types.BoolValue(product.GetInternetAccessible())
-	plan.EnableProductTagInheritance = types.BoolValue(product.GetEnableProductTagInheritance())
-	plan.EnableSimpleRiskAcceptance = types.BoolValue(product.GetEnableSimpleRiskAcceptance())
-	plan.EnableFullRiskAcceptance = types.BoolValue(product.GetEnableFullRiskAcceptance())
-	plan.DisableSlaBreachNotifications = types.BoolValue(product.GetDisableSlaBreachNotifications())
-	plan.ProductManager = types.Int64Value(int64(product.GetProductManager()))
-	plan.TechnicalContact = types.Int64Value(int64(product.GetTechnicalContact()))
-	plan.TeamManager = types.Int64Value(int64(product.GetTeamManager()))
+	plan.ProdNumericGrade = defectdojoNullableInt32ToBasetypesInt64Value(product.ProdNumericGrade)
+	plan.BusinessCriticality = defectdojoNullableStringToBasetypesStringValue(product.BusinessCriticality)
+	plan.Platform = defectdojoNullableStringToBasetypesStringValue(product.Platform)
+	plan.Lifecycle = defectdojoNullableStringToBasetypesStringValue(product.Lifecycle)
+	plan.Origin = defectdojoNullableStringToBasetypesStringValue(product.Origin)
+	plan.UserRecords = defectdojoNullableInt32ToBasetypesInt64Value(product.UserRecords)
+	plan.Revenue = defectdojoNullableFloat64ToBasetypesStringValue(product.Revenue)
+	plan.ExternalAudience = boolPointerToBasetypesBoolValue(product.ExternalAudience)
+	plan.InternetAccessible = boolPointerToBasetypesBoolValue(product.InternetAccessible)
+	plan.EnableProductTagInheritance = boolPointerToBasetypesBoolValue(product.EnableProductTagInheritance)
+	plan.EnableSimpleRiskAcceptance = boolPointerToBasetypesBoolValue(product.EnableSimpleRiskAcceptance)
+	plan.EnableFullRiskAcceptance = boolPointerToBasetypesBoolValue(product.EnableFullRiskAcceptance)
+	plan.DisableSlaBreachNotifications = boolPointerToBasetypesBoolValue(product.DisableSlaBreachNotifications)
+	plan.ProductManager = defectdojoNullableInt32ToBasetypesInt64Value(product.ProductManager)
+	plan.TechnicalContact = defectdojoNullableInt32ToBasetypesInt64Value(product.TechnicalContact)
+	plan.TeamManager = defectdojoNullableInt32ToBasetypesInt64Value(product.TeamManager)
 	plan.ProdType = types.Int64Value(int64(product.GetProdType()))
-	plan.SlaConfiguration = types.Int64Value(int64(product.GetSlaConfiguration()))
+	plan.SlaConfiguration = int32PointerToBasetypesInt64Value(product.SlaConfiguration)
+
+	// Manage metadata entries of the product if configured
+	if !plan.Metadata.IsNull() {
+		resp.Diagnostics.Append(syncProductMetadata(ctx, r.client, product.GetId(), plan.Metadata)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Metadata, diags = productMetadataFromAPI(ctx, r.client, product.GetId())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -363,24 +384,32 @@ func (r *productResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.ID = types.Int64Value(int64(product.GetId()))
 	state.Name = types.StringValue(product.GetName())
 	state.Description = types.StringValue(product.GetDescription())
-	state.ProdNumericGrade = types.Int64Value(int64(product.GetProdNumericGrade()))
-	state.BusinessCriticality = types.StringValue(product.GetBusinessCriticality())
-	state.Platform = types.StringValue(product.GetPlatform())
-	state.Lifecycle = types.StringValue(product.GetLifecycle())
-	state.Origin = types.StringValue(product.GetOrigin())
-	state.UserRecords = types.Int64Value(int64(product.GetUserRecords()))
-	state.Revenue = types.StringValue(fmt.Sprintf("%f", product.GetRevenue()))
-	state.ExternalAudience = types.BoolValue(product.GetExternalAudience())
-	state.InternetAccessible = types.BoolValue(product.GetInternetAccessible())
-	state.EnableProductTagInheritance = types.BoolValue(product.GetEnableProductTagInheritance())
-	state.EnableSimpleRiskAcceptance = types.BoolValue(product.GetEnableSimpleRiskAcceptance())
-	state.EnableFullRiskAcceptance = types.BoolValue(product.GetEnableFullRiskAcceptance())
-	state.DisableSlaBreachNotifications = types.BoolValue(product.GetDisableSlaBreachNotifications())
-	state.ProductManager = types.Int64Value(int64(product.GetProductManager()))
-	state.TechnicalContact = types.Int64Value(int64(product.GetTechnicalContact()))
-	state.TeamManager = types.Int64Value(int64(product.GetTeamManager()))
+	state.ProdNumericGrade = defectdojoNullableInt32ToBasetypesInt64Value(product.ProdNumericGrade)
+	state.BusinessCriticality = defectdojoNullableStringToBasetypesStringValue(product.BusinessCriticality)
+	state.Platform = defectdojoNullableStringToBasetypesStringValue(product.Platform)
+	state.Lifecycle = defectdojoNullableStringToBasetypesStringValue(product.Lifecycle)
+	state.Origin = defectdojoNullableStringToBasetypesStringValue(product.Origin)
+	state.UserRecords = defectdojoNullableInt32ToBasetypesInt64Value(product.UserRecords)
+	state.Revenue = defectdojoNullableFloat64ToBasetypesStringValue(product.Revenue)
+	state.ExternalAudience = boolPointerToBasetypesBoolValue(product.ExternalAudience)
+	state.InternetAccessible = boolPointerToBasetypesBoolValue(product.InternetAccessible)
+	state.EnableProductTagInheritance = boolPointerToBasetypesBoolValue(product.EnableProductTagInheritance)
+	state.EnableSimpleRiskAcceptance = boolPointerToBasetypesBoolValue(product.EnableSimpleRiskAcceptance)
+	state.EnableFullRiskAcceptance = boolPointerToBasetypesBoolValue(product.EnableFullRiskAcceptance)
+	state.DisableSlaBreachNotifications = boolPointerToBasetypesBoolValue(product.DisableSlaBreachNotifications)
+	state.ProductManager = defectdojoNullableInt32ToBasetypesInt64Value(product.ProductManager)
+	state.TechnicalContact = defectdojoNullableInt32ToBasetypesInt64Value(product.TechnicalContact)
+	state.TeamManager = defectdojoNullableInt32ToBasetypesInt64Value(product.TeamManager)
 	state.ProdType = types.Int64Value(int64(product.GetProdType()))
-	state.SlaConfiguration = types.Int64Value(int64(product.GetSlaConfiguration()))
+	state.SlaConfiguration = int32PointerToBasetypesInt64Value(product.SlaConfiguration)
+
+	if !state.Metadata.IsNull() {
+		state.Metadata, diags = productMetadataFromAPI(ctx, r.client, product.GetId())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -447,18 +476,18 @@ func (r *productResource) Update(ctx context.Context, req resource.UpdateRequest
 		Name:                          plan.Name.ValueString(),
 		Description:                   plan.Description.ValueString(),
 		ProdNumericGrade:              basetypesInt64ValueToDefectdojoNullableInt32(plan.ProdNumericGrade),
-		BusinessCriticality:           *defectdojo.NewNullableString(plan.BusinessCriticality.ValueStringPointer()),
-		Platform:                      *defectdojo.NewNullableString(plan.Platform.ValueStringPointer()),
-		Lifecycle:                     *defectdojo.NewNullableString(plan.Lifecycle.ValueStringPointer()),
-		Origin:                        *defectdojo.NewNullableString(plan.Origin.ValueStringPointer()),
+		BusinessCriticality:           basetypesStringValueToDefectdojoNullableString(plan.BusinessCriticality),
+		Platform:                      basetypesStringValueToDefectdojoNullableString(plan.Platform),
+		Lifecycle:                     basetypesStringValueToDefectdojoNullableString(plan.Lifecycle),
+		Origin:                        basetypesStringValueToDefectdojoNullableString(plan.Origin),
 		UserRecords:                   basetypesInt64ValueToDefectdojoNullableInt32(plan.UserRecords),
 		Revenue:                       *defectdojo.NewNullableFloat64(revenue),
-		ExternalAudience:              plan.ExternalAudience.ValueBoolPointer(),
-		InternetAccessible:            plan.InternetAccessible.ValueBoolPointer(),
-		EnableProductTagInheritance:   plan.EnableProductTagInheritance.ValueBoolPointer(),
-		EnableSimpleRiskAcceptance:    plan.EnableSimpleRiskAcceptance.ValueBoolPointer(),
-		EnableFullRiskAcceptance:      plan.EnableFullRiskAcceptance.ValueBoolPointer(),
-		DisableSlaBreachNotifications: plan.DisableSlaBreachNotifications.ValueBoolPointer(),
+		ExternalAudience:              basetypesBoolValueToBoolPointer(plan.ExternalAudience),
+		InternetAccessible:            basetypesBoolValueToBoolPointer(plan.InternetAccessible),
+		EnableProductTagInheritance:   basetypesBoolValueToBoolPointer(plan.EnableProductTagInheritance),
+		EnableSimpleRiskAcceptance:    basetypesBoolValueToBoolPointer(plan.EnableSimpleRiskAcceptance),
+		EnableFullRiskAcceptance:      basetypesBoolValueToBoolPointer(plan.EnableFullRiskAcceptance),
+		DisableSlaBreachNotifications: basetypesBoolValueToBoolPointer(plan.DisableSlaBreachNotifications),
 		ProductManager:                basetypesInt64ValueToDefectdojoNullableInt32(plan.ProductManager),
 		TechnicalContact:              basetypesInt64ValueToDefectdojoNullableInt32(plan.TechnicalContact),
 		TeamManager:                   basetypesInt64ValueToDefectdojoNullableInt32(plan.TeamManager),
@@ -490,24 +519,52 @@ func (r *productResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan.ID = types.Int64Value(int64(product.GetId()))
 	plan.Name = types.StringValue(product.GetName())
 	plan.Description = types.StringValue(product.GetDescription())
-	plan.ProdNumericGrade = types.Int64Value(int64(product.GetProdNumericGrade()))
-	plan.BusinessCriticality = types.StringValue(product.GetBusinessCriticality())
-	plan.Platform = types.StringValue(product.GetPlatform())
-	plan.Lifecycle = types.StringValue(product.GetLifecycle())
-	plan.Origin = types.StringValue(product.GetOrigin())
-	plan.UserRecords = types.Int64Value(int64(product.GetUserRecords()))
-	plan.Revenue = types.StringValue(fmt.Sprintf("%f", product.GetRevenue()))
-	plan.ExternalAudience = types.BoolValue(product.GetExternalAudience())
-	plan.InternetAccessible = types.BoolValue(product.GetInternetAccessible())
-	plan.EnableProductTagInheritance = types.BoolValue(product.GetEnableProductTagInheritance())
-	plan.EnableSimpleRiskAcceptance = types.BoolValue(product.GetEnableSimpleRiskAcceptance())
-	plan.EnableFullRiskAcceptance = types.BoolValue(product.GetEnableFullRiskAcceptance())
-	plan.DisableSlaBreachNotifications = types.BoolValue(product.GetDisableSlaBreachNotifications())
-	plan.ProductManager = types.Int64Value(int64(product.GetProductManager()))
-	plan.TechnicalContact = types.Int64Value(int64(product.GetTechnicalContact()))
-	plan.TeamManager = types.Int64Value(int64(product.GetTeamManager()))
+	plan.ProdNumericGrade = defectdojoNullableInt32ToBasetypesInt64Value(product.ProdNumericGrade)
+	plan.BusinessCriticality = defectdojoNullableStringToBasetypesStringValue(product.BusinessCriticality)
+	plan.Platform = defectdojoNullableStringToBasetypesStringValue(product.Platform)
+	plan.Lifecycle = defectdojoNullableStringToBasetypesStringValue(product.Lifecycle)
+	plan.Origin = defectdojoNullableStringToBasetypesStringValue(product.Origin)
+	plan.UserRecords = defectdojoNullableInt32ToBasetypesInt64Value(product.UserRecords)
+	plan.Revenue = defectdojoNullableFloat64ToBasetypesStringValue(product.Revenue)
+	plan.ExternalAudience = boolPointerToBasetypesBoolValue(product.ExternalAudience)
+	plan.InternetAccessible = boolPointerToBasetypesBoolValue(product.InternetAccessible)
+	plan.EnableProductTagInheritance = boolPointerToBasetypesBoolValue(product.EnableProductTagInheritance)
+	plan.EnableSimpleRiskAcceptance = boolPointerToBasetypesBoolValue(product.EnableSimpleRiskAcceptance)
+	plan.EnableFullRiskAcceptance = boolPointerToBasetypesBoolValue(product.EnableFullRiskAcceptance)
+	plan.DisableSlaBreachNotifications = boolPointerToBasetypesBoolValue(product.DisableSlaBreachNotifications)
+	plan.ProductManager = defectdojoNullableInt32ToBasetypesInt64Value(product.ProductManager)
+	plan.TechnicalContact = defectdojoNullableInt32ToBasetypesInt64Value(product.TechnicalContact)
+	plan.TeamManager = defectdojoNullableInt32ToBasetypesInt64Value(product.TeamManager)
 	plan.ProdType = types.Int64Value(int64(product.GetProdType()))
-	plan.SlaConfiguration = types.Int64Value(int64(product.GetSlaConfiguration()))
+	plan.SlaConfiguration = int32PointerToBasetypesInt64Value(product.SlaConfiguration)
+
+	// Metadata entries are left in place when the attribute is removed
+	var stateMetadata types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("metadata"), &stateMetadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Metadata.IsNull() && !stateMetadata.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("metadata"),
+			"Defectdojo Product Metadata No Longer Managed",
+			"The metadata attribute of product with ID "+plan.ID.String()+" was removed. Its metadata entries are left in place and are no longer managed by Terraform.",
+		)
+	}
+
+	// Manage metadata entries of the product if configured
+	if !plan.Metadata.IsNull() {
+		resp.Diagnostics.Append(syncProductMetadata(ctx, r.client, product.GetId(), plan.Metadata)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Metadata, diags = productMetadataFromAPI(ctx, r.client, product.GetId())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -561,4 +618,7 @@ func (r *productResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+
+	// an empty map makes the following read fetch all metadata entries of the product
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata"), types.MapValueMust(types.StringType, map[string]attr.Value{}))...)
 }
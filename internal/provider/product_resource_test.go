@@ -4,9 +4,11 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccProductResource(t *testing.T) {
@@ -42,6 +44,69 @@ func TestAccProductResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: false,
 			},
+			// Update and Read testing of metadata
+			{
+				Config: providerConfig + `
+				resource "defectdojo_product_type" "test_product_type" {
+					name             = "Test Product Type"
+					description      = "This is the description of the Test Product Type"
+					critical_product = true
+					key_product      = true
+				}
+
+				resource "defectdojo_product" "test" {
+					name             = "Test Product"
+					description      = "This is the description of the Test Product"
+					prod_type        = defectdojo_product_type.test_product_type.id
+					metadata = {
+						owner = "team-a"
+						repo  = "https://github.com/example/app"
+					}
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_product.test", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("defectdojo_product.test", "metadata.owner", "team-a"),
+					resource.TestCheckResourceAttr("defectdojo_product.test", "metadata.repo", "https://github.com/example/app"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "defectdojo_product_type" "test_product_type" {
+					name             = "Test Product Type"
+					description      = "This is the description of the Test Product Type"
+					critical_product = true
+					key_product      = true
+				}
+
+				resource "defectdojo_product" "test" {
+					name             = "Test Product"
+					description      = "This is the description of the Test Product"
+					prod_type        = defectdojo_product_type.test_product_type.id
+					metadata = {
+						owner = "team-b"
+					}
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_product.test", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("defectdojo_product.test", "metadata.owner", "team-b"),
+				),
+			},
+			// ImportState testing of metadata
+			{
+				ResourceName: "defectdojo_product.test",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if owner := states[0].Attributes["metadata.owner"]; owner != "team-b" {
+						return fmt.Errorf("expected the imported metadata owner to be team-b, got %q", owner)
+					}
+
+					return nil
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &productTechnologyResource{}
+	_ resource.ResourceWithConfigure   = &productTechnologyResource{}
+	_ resource.ResourceWithImportState = &productTechnologyResource{}
+)
+
+// NewProductTechnologyResource is a helper function to simplify the provider implementation.
+func NewProductTechnologyResource() resource.Resource {
+	return &productTechnologyResource{}
+}
+
+// productTechnologyResource is the resource implementation.
+type productTechnologyResource struct {
+	client *defectdojo.APIClient
+}
+
+type productTechnologyResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Product    types.Int64  `tfsdk:"product"`
+	Name       types.String `tfsdk:"name"`
+	Version    types.String `tfsdk:"version"`
+	Confidence types.Int64  `tfsdk:"confidence"`
+	Website    types.String `tfsdk:"website"`
+	Icon       types.String `tfsdk:"icon"`
+	User       types.Int64  `tfsdk:"user"`
+	Tags       types.List   `tfsdk:"tags"`
+}
+
+// Metadata returns the resource type name.
+func (r *productTechnologyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_technology"
+}
+
+// Schema defines the schema for the resource.
+func (r *productTechnologyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the product technology",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"product": schema.Int64Attribute{
+				Description: "The ID of the product using the technology",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the technology, e.g. nginx",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the technology",
+				Computed:    true,
+				Optional:    true,
+			},
+			"confidence": schema.Int64Attribute{
+				Description: "The confidence in percent that the product uses the technology",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"website": schema.StringAttribute{
+				Description: "The URL of the website of the technology",
+				Computed:    true,
+				Optional:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "The name of the icon of the technology",
+				Computed:    true,
+				Optional:    true,
+			},
+			"user": schema.Int64Attribute{
+				Description: "The ID of the user who added the technology",
+				Required:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of tags for the product technology",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *productTechnologyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *productTechnologyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan productTechnologyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	productTechnologyRequest := productTechnologyRequestFromPlan(plan, tags)
+
+	// Create new product technology
+	productTechnology, res, err := r.client.TechnologiesAPI.TechnologiesCreate(ctx).AppAnalysisRequest(productTechnologyRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Product Technology",
+			"Could not create product technology, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(productTechnology.GetId()))
+	mapProductTechnologyToModel(productTechnology, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), productTechnology.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *productTechnologyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state productTechnologyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed product technology value from Defectdojo
+	productTechnology, res, err := r.client.TechnologiesAPI.TechnologiesRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Product Technology",
+			"Could not read product technology with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(productTechnology.GetId()))
+	mapProductTechnologyToModel(productTechnology, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), productTechnology.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *productTechnologyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan productTechnologyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	productTechnologyRequest := productTechnologyRequestFromPlan(plan, tags)
+
+	// Update existing product technology
+	_, res, err := r.client.TechnologiesAPI.TechnologiesUpdate(ctx, int32(plan.ID.ValueInt64())).AppAnalysisRequest(productTechnologyRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Product Technology",
+			"Could not update product technology with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed product technology value from Defectdojo
+	productTechnology, res, err := r.client.TechnologiesAPI.TechnologiesRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Product Technology",
+			"Could not read product technology with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(productTechnology.GetId()))
+	mapProductTechnologyToModel(productTechnology, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), productTechnology.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *productTechnologyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state productTechnologyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing product technology
+	res, err := r.client.TechnologiesAPI.TechnologiesDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Product Technology",
+			"Could not delete product technology, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *productTechnologyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// productTechnologyRequestFromPlan generates the request for creating and updating a product technology.
+func productTechnologyRequestFromPlan(plan productTechnologyResourceModel, tags []string) defectdojo.AppAnalysisRequest {
+	return defectdojo.AppAnalysisRequest{
+		Product:    int32(plan.Product.ValueInt64()),
+		Name:       plan.Name.ValueString(),
+		Version:    basetypesStringValueToDefectdojoNullableString(plan.Version),
+		Confidence: basetypesInt64ValueToDefectdojoNullableInt32(plan.Confidence),
+		Website:    basetypesStringValueToDefectdojoNullableString(plan.Website),
+		Icon:       basetypesStringValueToDefectdojoNullableString(plan.Icon),
+		User:       int32(plan.User.ValueInt64()),
+		Tags:       tags,
+	}
+}
+
+// mapProductTechnologyToModel maps a product technology to the model, except for the tags.
+func mapProductTechnologyToModel(productTechnology *defectdojo.AppAnalysis, model *productTechnologyResourceModel) {
+	model.Product = types.Int64Value(int64(productTechnology.GetProduct()))
+	model.Name = types.StringValue(productTechnology.GetName())
+	model.Version = defectdojoNullableStringToBasetypesStringValue(productTechnology.Version)
+	model.Confidence = defectdojoNullableInt32ToBasetypesInt64Value(productTechnology.Confidence)
+	model.Website = defectdojoNullableStringToBasetypesStringValue(productTechnology.Website)
+	model.Icon = defectdojoNullableStringToBasetypesStringValue(productTechnology.Icon)
+	model.User = types.Int64Value(int64(productTechnology.GetUser()))
+}
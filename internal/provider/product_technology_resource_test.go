@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccProductTechnologyDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_user" "test_user" {
+	username = "technology-test-user"
+	email    = "technology-test-user@example.com"
+}
+`
+
+func TestAccProductTechnologyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccProductTechnologyDependencies + `
+				resource "defectdojo_product_technology" "test" {
+					product    = defectdojo_product.test_product.id
+					name       = "nginx"
+					version    = "1.27.0"
+					confidence = 100
+					user       = defectdojo_user.test_user.id
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttrPair("defectdojo_product_technology.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "name", "nginx"),
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "version", "1.27.0"),
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "confidence", "100"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_technology.test", "user", "defectdojo_user.test_user", "id"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_product_technology.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_technology.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccProductTechnologyDependencies + `
+				resource "defectdojo_product_technology" "test" {
+					product    = defectdojo_product.test_product.id
+					name       = "nginx"
+					version    = "1.27.1"
+					confidence = 80
+					website    = "https://nginx.org"
+					user       = defectdojo_user.test_user.id
+					tags       = ["sbom"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "version", "1.27.1"),
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "confidence", "80"),
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "website", "https://nginx.org"),
+					resource.TestCheckResourceAttr("defectdojo_product_technology.test", "tags.0", "sbom"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
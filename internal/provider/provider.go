@@ -4,29 +4,42 @@
 package provider
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prempador/go-defectdojo"
 )
 
 // Ensure DefectDojoProvider satisfies various provider interfaces.
-var _ provider.Provider = &DefectdojoProvider{}
+var (
+	_ provider.Provider                       = &DefectdojoProvider{}
+	_ provider.ProviderWithEphemeralResources = &DefectdojoProvider{}
+	_ provider.ProviderWithConfigValidators   = &DefectdojoProvider{}
+)
+
+// authSchemes are the supported schemes of the Authorization header.
+var authSchemes = []string{"Token", "Bearer"}
 
 // DefectdojoProvider defines the provider implementation.
 type DefectdojoProvider struct {
@@ -42,8 +55,26 @@ type DefectdojoProviderModel struct {
 	Username              types.String `tfsdk:"username"`
 	Password              types.String `tfsdk:"password"`
 	Token                 types.String `tfsdk:"token"`
+	TokenFile             types.String `tfsdk:"token_file"`
+	TokenCacheDir         types.String `tfsdk:"token_cache_dir"`
+	TokenCacheTTL         types.String `tfsdk:"token_cache_ttl"`
 	HTTPProxy             types.String `tfsdk:"http_proxy"`
 	TLSInsecureSkipVerify types.Bool   `tfsdk:"tls_insecure_skip_verify"`
+	AuthScheme            types.String `tfsdk:"auth_scheme"`
+	Headers               types.Map    `tfsdk:"headers"`
+}
+
+// DefectdojoProviderData is passed to data sources, resources and ephemeral resources during Configure.
+// Besides the generated API client it carries everything needed
+// for requests the generated client cannot perform.
+type DefectdojoProviderData struct {
+	Client     *defectdojo.APIClient
+	HTTPClient *http.Client
+	Host       string
+	UserAgent  string
+	Headers    map[string]string
+	Username   string
+	Password   string
 }
 
 func (p *DefectdojoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,8 +86,11 @@ func (p *DefectdojoProvider) Schema(ctx context.Context, req provider.SchemaRequ
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				MarkdownDescription: "The host of the defectdojo instance",
+				MarkdownDescription: "The host of the defectdojo instance, including the scheme and an optional path prefix, e.g. `https://defectdojo.example.com` or `https://tools.example.com/defectdojo`",
 				Optional:            true,
+				Validators: []validator.String{
+					hostValidator{},
+				},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "The username of the defectdojo user (required if token is not set)",
@@ -68,22 +102,63 @@ func (p *DefectdojoProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Sensitive:           true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "The token of the defectdojo user (required if username and password are not set)",
+				MarkdownDescription: "The token of the defectdojo user (required if username and password are not set, conflicts with `username`, `password` and `token_file`)",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the token of the defectdojo user, e.g. a token obtained by a previous step of a pipeline",
+				Optional:            true,
+			},
+			"token_cache_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory to cache tokens fetched with username and password in, so other provider instances can reuse them instead of logging in again",
+				Optional:            true,
+			},
+			"token_cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "How long a cached token is reused before logging in again, e.g. `12h` (defaults to `24h`)",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 			"http_proxy": schema.StringAttribute{
 				MarkdownDescription: "The HTTP proxy to use for requests to the defectdojo API",
 				Optional:            true,
+				Validators: []validator.String{
+					proxyValidator{},
+				},
 			},
 			"tls_insecure_skip_verify": schema.BoolAttribute{
 				MarkdownDescription: "Whether to insecurely skip verifying the server's certificate chain and host name",
 				Optional:            true,
 			},
+			"auth_scheme": schema.StringAttribute{
+				MarkdownDescription: "The scheme of the `Authorization` header, `Token` for Defectdojo API tokens or `Bearer` for tokens of an OIDC proxy in front of Defectdojo, which requires `token` or `token_file` (defaults to `Token`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authSchemes...),
+				},
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request, e.g. for an identity-aware proxy in front of Defectdojo. The `Authorization` header is managed by the provider and can't be set here",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOfCaseInsensitive("Authorization")),
+				},
+			},
 		},
 	}
 }
 
+func (p *DefectdojoProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(path.MatchRoot("token"), path.MatchRoot("username")),
+		providervalidator.Conflicting(path.MatchRoot("token"), path.MatchRoot("password")),
+		providervalidator.Conflicting(path.MatchRoot("token"), path.MatchRoot("token_file")),
+	}
+}
+
 func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data DefectdojoProviderModel
 
@@ -100,8 +175,18 @@ func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.Configu
 	username := os.Getenv("DEFECTDOJO_USERNAME")
 	password := os.Getenv("DEFECTDOJO_PASSWORD")
 	token := os.Getenv("DEFECTDOJO_TOKEN")
+	tokenFile := os.Getenv("DEFECTDOJO_TOKEN_FILE")
+	tokenCacheDir := os.Getenv("DEFECTDOJO_TOKEN_CACHE_DIR")
+	tokenCacheTTL := os.Getenv("DEFECTDOJO_TOKEN_CACHE_TTL")
 	httpProxy := os.Getenv("DEFECTDOJO_HTTP_PROXY")
 	insecureSkipVerify := os.Getenv("DEFECTDOJO_TLS_INSECURE_SKIP_VERIFY")
+	authScheme := os.Getenv("DEFECTDOJO_AUTH_SCHEME")
+
+	headers, err := parseHeaders(os.Getenv("DEFECTDOJO_HEADERS"))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("headers"), "Failed to parse DEFECTDOJO_HEADERS", "Failed to parse DEFECTDOJO_HEADERS: "+err.Error())
+		return
+	}
 
 	if !data.Host.IsNull() {
 		host = data.Host.ValueString()
@@ -111,6 +196,18 @@ func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.Configu
 		token = data.Token.ValueString()
 	}
 
+	if !data.TokenFile.IsNull() {
+		tokenFile = data.TokenFile.ValueString()
+	}
+
+	if !data.TokenCacheDir.IsNull() {
+		tokenCacheDir = data.TokenCacheDir.ValueString()
+	}
+
+	if !data.TokenCacheTTL.IsNull() {
+		tokenCacheTTL = data.TokenCacheTTL.ValueString()
+	}
+
 	if !data.Username.IsNull() {
 		username = data.Username.ValueString()
 	}
@@ -127,6 +224,32 @@ func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.Configu
 		insecureSkipVerify = strconv.FormatBool(data.TLSInsecureSkipVerify.ValueBool())
 	}
 
+	if !data.AuthScheme.IsNull() {
+		authScheme = data.AuthScheme.ValueString()
+	}
+
+	// headers from the configuration are added to the ones from the environment
+	if !data.Headers.IsNull() {
+		configHeaders := make(map[string]string, len(data.Headers.Elements()))
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &configHeaders, false)...)
+
+		for name, value := range configHeaders {
+			headers[name] = value
+		}
+	}
+
+	if authScheme == "" {
+		authScheme = "Token"
+	}
+
+	if !slices.Contains(authSchemes, authScheme) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_scheme"),
+			"Invalid Defectdojo API Auth Scheme",
+			fmt.Sprintf("The auth scheme has to be one of %s, got: %q", strings.Join(authSchemes, ", "), authScheme),
+		)
+	}
+
 	if host == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
@@ -137,6 +260,51 @@ func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	// a token from a file is used as if it was configured directly
+	if token == "" && tokenFile != "" {
+		content, err := os.ReadFile(tokenFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Failed to read Defectdojo API Token File",
+				"Failed to read Defectdojo API Token File: "+err.Error(),
+			)
+		}
+
+		token = strings.TrimSpace(string(content))
+	}
+
+	// tokens fetched with username and password are Defectdojo API tokens,
+	// which Defectdojo only accepts with the Token scheme
+	if authScheme == "Bearer" && token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_scheme"),
+			"Invalid Defectdojo API Auth Scheme",
+			"The Bearer auth scheme requires a token or token_file. Tokens fetched with the username and password are Defectdojo API tokens and always use the Token scheme.",
+		)
+	}
+
+	var cache *tokenCache
+	if tokenCacheDir != "" {
+		cache = &tokenCache{
+			dir: tokenCacheDir,
+			ttl: 24 * time.Hour,
+		}
+
+		if tokenCacheTTL != "" {
+			ttl, err := time.ParseDuration(tokenCacheTTL)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("token_cache_ttl"),
+					"Failed to parse Defectdojo API Token Cache TTL",
+					"Failed to parse Defectdojo API Token Cache TTL: "+err.Error(),
+				)
+			}
+
+			cache.ttl = ttl
+		}
+	}
+
 	// if token is empty we require username and password to be set to fetch a token
 	if token == "" {
 		if username == "" {
@@ -166,9 +334,9 @@ func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.Configu
 
 	transport := cleanhttp.DefaultPooledTransport()
 	if httpProxy != "" {
-		proxyURL, err := url.Parse(httpProxy)
+		proxyURL, err := parseProxy(httpProxy)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to parse HTTP Proxy", "Failed to parse HTTP Proxy: "+err.Error())
+			resp.Diagnostics.AddAttributeError(path.Root("http_proxy"), "Failed to parse HTTP Proxy", "Failed to parse HTTP Proxy: "+err.Error())
 			return
 		}
 		transport.Proxy = http.ProxyURL(proxyURL)
@@ -190,97 +358,148 @@ func (p *DefectdojoProvider) Configure(ctx context.Context, req provider.Configu
 
 	httpClient.Logger = nil // disable logging
 
-	parsedHost, err := url.Parse(host)
+	// the host might come from the environment, so it was not necessarily validated yet
+	parsedHost, err := parseHost(host)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to parse Defectdojo API Host", "Failed to parse Defectdojo API Host: "+err.Error())
+		resp.Diagnostics.AddAttributeError(path.Root("host"), "Failed to parse Defectdojo API Host", "Failed to parse Defectdojo API Host: "+err.Error())
 		return
 	}
 
-	cfg := defectdojo.NewConfiguration()
-	cfg.Host = parsedHost.Host
-	cfg.Scheme = parsedHost.Scheme
-	cfg.HTTPClient = httpClient.StandardClient()
-	cfg.UserAgent = "terraform-provider-defectdojo/" + p.version
-
-	// if token is empty we are fetching a new one with the username and password
-	if token == "" {
-		// we have to go oldschool here because the openapi definition for the api token endpoint is not working
-		body := []byte(fmt.Sprintf(`{
-			"username": "%s",
-			"password": "%s"
-		}`, username, password))
-
-		// Create a HTTP post request
-		r, err := http.NewRequest("POST", host+"/api/v2/api-token-auth/", bytes.NewBuffer(body))
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to create POST Request to fetch API Token", "Failed to create POST Request to fetch API Token: "+err.Error())
-
-			return
+	// from here on host is the normalized base URL without trailing slash,
+	// including the path prefix if Defectdojo is served behind one
+	host = parsedHost.String()
+
+	standardClient := httpClient.StandardClient()
+	userAgent := "terraform-provider-defectdojo/" + p.version
+
+	// login fetches a new token with the username and password.
+	// besides the initial login it is used when Defectdojo rejects a token mid-run.
+	// a rejected Bearer token can't be replaced by a Defectdojo API token.
+	var login func(ctx context.Context) (string, error)
+	if username != "" && password != "" && authScheme == "Token" {
+		login = func(ctx context.Context) (string, error) {
+			token, err := fetchAPIToken(ctx, standardClient, host, userAgent, headers, username, password)
+			if err != nil {
+				return "", err
+			}
+
+			// the cache only saves logins, so failing to write it is not an error
+			if cache != nil {
+				_ = cache.store(host, username, token)
+			}
+
+			return token, nil
 		}
+	}
 
-		r.Header.Add("Content-Type", "application/json")
-		r.Header.Add("user-agent", "terraform-provider-defectdojo/"+p.version)
+	if token == "" && cache != nil {
+		token = cache.load(host, username)
+	}
 
-		res, err := httpClient.StandardClient().Do(r)
+	// if token is empty we are fetching a new one with the username and password
+	if token == "" {
+		token, err = login(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to create POST Request to fetch API Token", "Failed to create POST Request to fetch API Token: "+err.Error())
-
-			return
-		}
-
-		defer res.Body.Close()
-
-		response := struct {
-			Token string `json:"token"`
-		}{}
-
-		derr := json.NewDecoder(res.Body).Decode(&response)
-		if derr != nil {
-			resp.Diagnostics.AddError("Failed to decode Defectdojo API Response", "Failed to decode Defectdojo API Response: "+err.Error())
+			resp.Diagnostics.AddError("Failed to fetch Defectdojo API Token", "Failed to fetch Defectdojo API Token: "+err.Error())
 
 			return
 		}
-
-		// this would be how it would work if the definition was correct
-
-		// c := defectdojo.NewAPIClient(cfg)
-
-		// ddtoken, res, err := c.ApiTokenAuthAPI.ApiTokenAuthCreate(context.Background()).Username(username).Password(password).Execute()
-		// if err != nil {
-		// 	resp.Diagnostics.AddError("Failed to authenticate with Defectdojo API", "Failed to authenticate with Defectdojo API: "+err.Error()+fmt.Sprintf("%+v %s %s", res, username, password))
-		// 	return
-		// }
-
-		token = response.Token
 	}
 
-	cfg.AddDefaultHeader("Authorization", "Token "+token)
+	cfg := defectdojo.NewConfiguration()
+	// the generated client appends the full /api/v2/... path to the server URL,
+	// so the base URL keeps a possible path prefix. Host and Scheme have to stay
+	// empty as they would override the server URL.
+	cfg.Servers = defectdojo.ServerConfigurations{defectdojo.ServerConfiguration{
+		URL:         host,
+		Description: "Defectdojo instance",
+	}}
+	cfg.HTTPClient = &http.Client{
+		Transport: &authTransport{
+			base:   standardClient.Transport,
+			login:  login,
+			scheme: authScheme,
+			token:  token,
+		},
+	}
+	cfg.UserAgent = userAgent
+	for name, value := range headers {
+		cfg.AddDefaultHeader(name, value)
+	}
 	client := defectdojo.NewAPIClient(cfg)
 
-	// Make the defectdojo client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &DefectdojoProviderData{
+		Client:     client,
+		HTTPClient: standardClient,
+		Host:       host,
+		UserAgent:  cfg.UserAgent,
+		Headers:    headers,
+		Username:   username,
+		Password:   password,
+	}
+
+	// Make the defectdojo client available during DataSource, Resource
+	// and EphemeralResource type Configure methods.
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 func (p *DefectdojoProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAnnouncementResource,
+		NewAPITokenResource,
+		NewCredentialResource,
+		NewCredentialMappingResource,
+		NewDevelopmentEnvironmentResource,
 		NewDojoGroupResource,
 		NewDojoGroupMemberResource,
+		NewEndpointResource,
+		NewEngagementPresetResource,
 		NewEngagementResource,
+		NewFindingTemplateResource,
+		NewJiraInstanceResource,
+		NewJiraProductConfigurationResource,
+		NewMetadataResource,
+		NewNetworkLocationResource,
+		NewNoteResource,
+		NewNoteTypeResource,
+		NewNotificationsResource,
+		NewProductLanguageResource,
 		NewProductResource,
+		NewProductTechnologyResource,
 		NewProductTypeResource,
+		NewRegulationResource,
+		NewRiskAcceptanceResource,
+		NewSLAConfigurationResource,
+		NewSystemSettingsResource,
+		NewTestTypeResource,
+		NewToolConfigurationResource,
+		NewToolProductSettingResource,
+		NewToolTypeResource,
 		NewUserResource,
 	}
 }
 
 func (p *DefectdojoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDevelopmentEnvironmentDataSource,
+		NewLanguageTypesDataSource,
+		NewNetworkLocationDataSource,
 		NewProductTypesDataSource,
+		NewRegulationDataSource,
+		NewSLAConfigurationDataSource,
+		NewTestTypeDataSource,
 		NewUsersDataSource,
 	}
 }
 
+func (p *DefectdojoProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPITokenEphemeralResource,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &DefectdojoProvider{
@@ -288,3 +507,29 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// parseHeaders parses headers in the format of the DEFECTDOJO_HEADERS environment variable,
+// a comma separated list of name=value pairs.
+func parseHeaders(s string) (map[string]string, error) {
+	headers := map[string]string{}
+
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("expected a header in the format name=value, got: %q", pair)
+		}
+
+		if strings.EqualFold(name, "Authorization") {
+			return nil, errors.New("the Authorization header is managed by the provider and can't be set")
+		}
+
+		headers[name] = strings.TrimSpace(value)
+	}
+
+	return headers, nil
+}
@@ -4,12 +4,20 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -30,6 +38,12 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"defectdojo": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccWriteOnlyVersionChecks skips acceptance tests that configure write-only
+// attributes, which require Terraform 1.11 or later.
+var testAccWriteOnlyVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(tfversion.Version1_11_0),
+}
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
@@ -59,3 +73,94 @@ func testDefectdojoHost(t *testing.T) {
 		t.Fatal("DEFECTDOJO_HOST must be set for this acceptance test")
 	}
 }
+
+func TestUnitParseHeaders(t *testing.T) {
+	headers, err := parseHeaders("")
+	require.NoError(t, err)
+	require.Empty(t, headers)
+
+	headers, err = parseHeaders("X-Proxy-Auth=secret, X-Team = appsec,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"X-Proxy-Auth": "secret", "X-Team": "appsec"}, headers)
+
+	_, err = parseHeaders("X-Proxy-Auth")
+	require.Error(t, err)
+
+	_, err = parseHeaders("authorization=Token secret")
+	require.Error(t, err)
+}
+
+// testProviderConfigure runs Configure of the provider with the given string attributes set.
+func testProviderConfigure(t *testing.T, attributes map[string]string) *provider.ConfigureResponse {
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+
+	return resp
+}
+
+func TestUnitConfigureBearerWithUsernameAndPassword(t *testing.T) {
+	for _, name := range []string{"DEFECTDOJO_TOKEN", "DEFECTDOJO_TOKEN_FILE", "DEFECTDOJO_TOKEN_CACHE_DIR", "DEFECTDOJO_TOKEN_CACHE_TTL", "DEFECTDOJO_HTTP_PROXY", "DEFECTDOJO_TLS_INSECURE_SKIP_VERIFY", "DEFECTDOJO_AUTH_SCHEME", "DEFECTDOJO_HEADERS"} {
+		t.Setenv(name, "")
+	}
+
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins++
+		_, err := w.Write([]byte(`{"token": "secret"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	// a token fetched with username and password can't be sent with the Bearer scheme
+	resp := testProviderConfigure(t, map[string]string{
+		"host":        server.URL,
+		"username":    "user",
+		"password":    "password",
+		"auth_scheme": "Bearer",
+	})
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "Invalid Defectdojo API Auth Scheme", resp.Diagnostics.Errors()[0].Summary())
+	require.Equal(t, 0, logins)
+
+	// a static token is sent as is
+	resp = testProviderConfigure(t, map[string]string{
+		"host":        server.URL,
+		"token":       "oidc-token",
+		"auth_scheme": "Bearer",
+	})
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.Equal(t, 0, logins)
+
+	// the Token scheme still logs in with username and password
+	resp = testProviderConfigure(t, map[string]string{
+		"host":     server.URL,
+		"username": "user",
+		"password": "password",
+	})
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.Equal(t, 1, logins)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &RegulationDataSource{}
+	_ datasource.DataSourceWithConfigure = &RegulationDataSource{}
+)
+
+func NewRegulationDataSource() datasource.DataSource {
+	return &RegulationDataSource{}
+}
+
+// RegulationDataSource defines the data source implementation.
+type RegulationDataSource struct {
+	client *defectdojo.APIClient
+}
+
+// RegulationDataSourceModel describes the data source data model.
+type RegulationDataSourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Acronym      types.String `tfsdk:"acronym"`
+	Category     types.String `tfsdk:"category"`
+	Jurisdiction types.String `tfsdk:"jurisdiction"`
+	Description  types.String `tfsdk:"description"`
+	Reference    types.String `tfsdk:"reference"`
+}
+
+// Metadata returns the data source type name.
+func (d *RegulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regulation"
+}
+
+// Schema defines the schema for the data source.
+func (d *RegulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the regulation",
+				Computed:    true,
+			},
+			"acronym": schema.StringAttribute{
+				Description: "The acronym of the regulation to look up, e.g. GDPR",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the regulation",
+				Computed:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "The category of the regulation",
+				Computed:    true,
+			},
+			"jurisdiction": schema.StringAttribute{
+				Description: "The territory the regulation applies to",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the regulation",
+				Computed:    true,
+			},
+			"reference": schema.StringAttribute{
+				Description: "The URL of the regulation",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RegulationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *RegulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state RegulationDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regulation, res, err := findInPages(func(offset int32) ([]defectdojo.Regulation, bool, *http.Response, error) {
+		regulations, res, err := d.client.RegulationsAPI.RegulationsList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return regulations.Results, regulations.Next.Get() != nil, res, nil
+	}, func(regulation *defectdojo.Regulation) bool {
+		return regulation.GetAcronym() == state.Acronym.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Regulations",
+			"Could not read regulations, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	if regulation == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("acronym"),
+			"Regulation Not Found",
+			"Could not find a regulation with the acronym "+state.Acronym.String(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.Int64Value(int64(regulation.GetId()))
+	state.Name = types.StringValue(regulation.GetName())
+	state.Category = types.StringValue(regulation.GetCategory())
+	state.Jurisdiction = types.StringValue(regulation.GetJurisdiction())
+	state.Description = emptyStringToBasetypesStringNull(regulation.GetDescription())
+	state.Reference = emptyStringToBasetypesStringNull(regulation.GetReference())
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
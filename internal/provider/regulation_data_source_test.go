@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegulationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_regulation" "test" {
+					name         = "Test Regulation Lookup"
+					acronym      = "TRL"
+					category     = "finance"
+					jurisdiction = "Test Jurisdiction"
+				}
+
+				data "defectdojo_regulation" "test" {
+					acronym = defectdojo_regulation.test.acronym
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the regulation was found by its acronym
+					resource.TestCheckResourceAttrPair("data.defectdojo_regulation.test", "id", "defectdojo_regulation.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_regulation.test", "name", "Test Regulation Lookup"),
+					resource.TestCheckResourceAttr("data.defectdojo_regulation.test", "category", "finance"),
+				),
+			},
+		},
+	})
+}
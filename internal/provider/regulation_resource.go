@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &regulationResource{}
+	_ resource.ResourceWithConfigure   = &regulationResource{}
+	_ resource.ResourceWithImportState = &regulationResource{}
+)
+
+// regulationCategories are the categories a regulation can belong to.
+var regulationCategories = []string{"privacy", "finance", "education", "medical", "corporate", "security", "government", "other"}
+
+// NewRegulationResource is a helper function to simplify the provider implementation.
+func NewRegulationResource() resource.Resource {
+	return &regulationResource{}
+}
+
+// regulationResource is the resource implementation.
+type regulationResource struct {
+	client *defectdojo.APIClient
+}
+
+type regulationResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Acronym      types.String `tfsdk:"acronym"`
+	Category     types.String `tfsdk:"category"`
+	Jurisdiction types.String `tfsdk:"jurisdiction"`
+	Description  types.String `tfsdk:"description"`
+	Reference    types.String `tfsdk:"reference"`
+}
+
+// Metadata returns the resource type name.
+func (r *regulationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regulation"
+}
+
+// Schema defines the schema for the resource.
+func (r *regulationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the regulation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the regulation, e.g. General Data Protection Regulation",
+				Required:    true,
+			},
+			"acronym": schema.StringAttribute{
+				Description: "The unique acronym of the regulation, e.g. GDPR",
+				Required:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "The category of the regulation, one of privacy, finance, education, medical, corporate, security, government or other",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(regulationCategories...),
+				},
+			},
+			"jurisdiction": schema.StringAttribute{
+				Description: "The territory the regulation applies to, e.g. European Union",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the regulation",
+				Computed:    true,
+				Optional:    true,
+			},
+			"reference": schema.StringAttribute{
+				Description: "The URL of the regulation",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *regulationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *regulationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan regulationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	regulationRequest := regulationRequestFromPlan(plan)
+
+	// Create new regulation
+	regulation, res, err := r.client.RegulationsAPI.RegulationsCreate(ctx).RegulationRequest(regulationRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Regulation",
+			"Could not create regulation, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(regulation.GetId()))
+	mapRegulationToModel(regulation, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *regulationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state regulationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed regulation value from Defectdojo
+	regulation, res, err := r.client.RegulationsAPI.RegulationsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Regulation",
+			"Could not read regulation with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(regulation.GetId()))
+	mapRegulationToModel(regulation, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *regulationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan regulationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	regulationRequest := regulationRequestFromPlan(plan)
+
+	// Update existing regulation
+	_, res, err := r.client.RegulationsAPI.RegulationsUpdate(ctx, int32(plan.ID.ValueInt64())).RegulationRequest(regulationRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Regulation",
+			"Could not update regulation with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed regulation value from Defectdojo
+	regulation, res, err := r.client.RegulationsAPI.RegulationsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Regulation",
+			"Could not read regulation with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(regulation.GetId()))
+	mapRegulationToModel(regulation, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *regulationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state regulationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing regulation
+	res, err := r.client.RegulationsAPI.RegulationsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Regulation",
+			"Could not delete regulation, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *regulationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// regulationRequestFromPlan generates the request for creating and updating a regulation.
+func regulationRequestFromPlan(plan regulationResourceModel) defectdojo.RegulationRequest {
+	return defectdojo.RegulationRequest{
+		Name:         plan.Name.ValueString(),
+		Acronym:      plan.Acronym.ValueString(),
+		Category:     plan.Category.ValueString(),
+		Jurisdiction: plan.Jurisdiction.ValueString(),
+		Description:  basetypesStringValueToStringPointer(plan.Description),
+		Reference:    basetypesStringValueToStringPointer(plan.Reference),
+	}
+}
+
+// mapRegulationToModel maps a regulation to the model.
+// empty descriptions and references are mapped to null, as Defectdojo doesn't distinguish them.
+func mapRegulationToModel(regulation *defectdojo.Regulation, model *regulationResourceModel) {
+	model.Name = types.StringValue(regulation.GetName())
+	model.Acronym = types.StringValue(regulation.GetAcronym())
+	model.Category = types.StringValue(regulation.GetCategory())
+	model.Jurisdiction = types.StringValue(regulation.GetJurisdiction())
+	model.Description = emptyStringToBasetypesStringNull(regulation.GetDescription())
+	model.Reference = emptyStringToBasetypesStringNull(regulation.GetReference())
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegulationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_regulation" "test" {
+					name         = "Test Regulation"
+					acronym      = "TR"
+					category     = "privacy"
+					jurisdiction = "Test Jurisdiction"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "name", "Test Regulation"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "acronym", "TR"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "category", "privacy"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "jurisdiction", "Test Jurisdiction"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_regulation.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_regulation.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_regulation" "test" {
+					name         = "Test Regulation"
+					acronym      = "TR"
+					category     = "security"
+					jurisdiction = "Test Jurisdiction"
+					description  = "This is the description of the Test Regulation"
+					reference    = "https://example.com/test-regulation"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "category", "security"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "description", "This is the description of the Test Regulation"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "reference", "https://example.com/test-regulation"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
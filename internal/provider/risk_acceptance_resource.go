@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &riskAcceptanceResource{}
+	_ resource.ResourceWithConfigure   = &riskAcceptanceResource{}
+	_ resource.ResourceWithImportState = &riskAcceptanceResource{}
+	_ resource.ResourceWithModifyPlan  = &riskAcceptanceResource{}
+)
+
+// riskAcceptanceTreatments are the possible recommendations and decisions for a risk.
+var riskAcceptanceTreatments = []string{"A", "V", "M", "F", "T"}
+
+// NewRiskAcceptanceResource is a helper function to simplify the provider implementation.
+func NewRiskAcceptanceResource() resource.Resource {
+	return &riskAcceptanceResource{}
+}
+
+// riskAcceptanceResource is the resource implementation.
+type riskAcceptanceResource struct {
+	client *defectdojo.APIClient
+}
+
+type riskAcceptanceResourceModel struct {
+	ID                    types.Int64  `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Engagement            types.Int64  `tfsdk:"engagement"`
+	AcceptedFindings      types.List   `tfsdk:"accepted_findings"`
+	Recommendation        types.String `tfsdk:"recommendation"`
+	RecommendationDetails types.String `tfsdk:"recommendation_details"`
+	Decision              types.String `tfsdk:"decision"`
+	DecisionDetails       types.String `tfsdk:"decision_details"`
+	AcceptedBy            types.String `tfsdk:"accepted_by"`
+	Owner                 types.Int64  `tfsdk:"owner"`
+	ExpirationDate        types.String `tfsdk:"expiration_date"`
+	ReactivateExpired     types.Bool   `tfsdk:"reactivate_expired"`
+	RestartSLAExpired     types.Bool   `tfsdk:"restart_sla_expired"`
+	Notes                 types.List   `tfsdk:"notes"`
+}
+
+// Metadata returns the resource type name.
+func (r *riskAcceptanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_risk_acceptance"
+}
+
+// Schema defines the schema for the resource.
+func (r *riskAcceptanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a risk acceptance. Defectdojo binds a risk acceptance to the engagement of its accepted findings, so all findings have to belong to the same engagement. Binding a risk acceptance to a product isn't supported",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the risk acceptance",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A descriptive name for the risk acceptance",
+				Required:    true,
+			},
+			"engagement": schema.Int64Attribute{
+				Description: "The ID of the engagement the risk acceptance belongs to, taken from the accepted findings",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted_findings": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Description: "List of IDs of the accepted findings. All findings have to belong to the same engagement",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"recommendation": schema.StringAttribute{
+				Description: "The recommendation of the security team, one of A (accept), V (avoid), M (mitigate), F (fix) or T (transfer)",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(riskAcceptanceTreatments...),
+				},
+			},
+			"recommendation_details": schema.StringAttribute{
+				Description: "Explanation of the recommendation",
+				Computed:    true,
+				Optional:    true,
+			},
+			"decision": schema.StringAttribute{
+				Description: "The decision of the risk owner, one of A (accept), V (avoid), M (mitigate), F (fix) or T (transfer)",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(riskAcceptanceTreatments...),
+				},
+			},
+			"decision_details": schema.StringAttribute{
+				Description: "Explanation of the decision, e.g. a link to the approved exception ticket",
+				Computed:    true,
+				Optional:    true,
+			},
+			"accepted_by": schema.StringAttribute{
+				Description: "The person that accepts the risk, can be outside of Defectdojo",
+				Computed:    true,
+				Optional:    true,
+			},
+			"owner": schema.Int64Attribute{
+				Description: "The ID of the user that owns the risk acceptance",
+				Required:    true,
+			},
+			"expiration_date": schema.StringAttribute{
+				Description: "The date the risk acceptance expires in the format YYYY-MM-DD. A new or changed expiration date has to be in the future",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+			},
+			"reactivate_expired": schema.BoolAttribute{
+				Description: "Whether the accepted findings are reactivated when the risk acceptance expires",
+				Computed:    true,
+				Optional:    true,
+			},
+			"restart_sla_expired": schema.BoolAttribute{
+				Description: "Whether the SLA of the accepted findings is restarted when the risk acceptance expires",
+				Computed:    true,
+				Optional:    true,
+			},
+			"notes": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Description: "List of IDs of the notes of the risk acceptance",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *riskAcceptanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *riskAcceptanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan riskAcceptanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	riskAcceptanceRequest, diags := riskAcceptanceRequestFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new risk acceptance
+	riskAcceptance, res, err := r.client.RiskAcceptanceAPI.RiskAcceptanceCreate(ctx).RiskAcceptanceRequest(riskAcceptanceRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Risk Acceptance",
+			"Could not create risk acceptance, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(riskAcceptance.GetId()))
+	resp.Diagnostics.Append(mapRiskAcceptanceToModel(ctx, riskAcceptance, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defectdojo doesn't return the engagement, so it is taken from the accepted findings
+	engagement, res, err := findingEngagement(ctx, r.client, riskAcceptanceRequest.AcceptedFindings[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Risk Acceptance",
+			"Could not read engagement of risk acceptance with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+	plan.Engagement = types.Int64Value(int64(engagement))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *riskAcceptanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state riskAcceptanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed risk acceptance value from Defectdojo
+	riskAcceptance, res, err := r.client.RiskAcceptanceAPI.RiskAcceptanceRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Risk Acceptance",
+			"Could not read risk acceptance with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(riskAcceptance.GetId()))
+	resp.Diagnostics.Append(mapRiskAcceptanceToModel(ctx, riskAcceptance, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Defectdojo doesn't return the engagement, so it is taken from the accepted findings after an import.
+	// The engagement of a risk acceptance can't change, so it is only looked up once.
+	if state.Engagement.IsNull() && len(riskAcceptance.AcceptedFindings) > 0 {
+		engagement, res, err := findingEngagement(ctx, r.client, riskAcceptance.AcceptedFindings[0])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Defectdojo Risk Acceptance",
+				"Could not read engagement of risk acceptance with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+			)
+			return
+		}
+		state.Engagement = types.Int64Value(int64(engagement))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *riskAcceptanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan riskAcceptanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	riskAcceptanceRequest, diags := riskAcceptanceRequestFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing risk acceptance
+	_, res, err := r.client.RiskAcceptanceAPI.RiskAcceptanceUpdate(ctx, int32(plan.ID.ValueInt64())).RiskAcceptanceRequest(riskAcceptanceRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Risk Acceptance",
+			"Could not update risk acceptance with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed risk acceptance value from Defectdojo
+	riskAcceptance, res, err := r.client.RiskAcceptanceAPI.RiskAcceptanceRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Risk Acceptance",
+			"Could not read risk acceptance with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(riskAcceptance.GetId()))
+	resp.Diagnostics.Append(mapRiskAcceptanceToModel(ctx, riskAcceptance, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan checks that a new or changed expiration date is in the future.
+// Unchanged dates are not checked, so an expired risk acceptance can still be refreshed, updated and destroyed.
+func (r *riskAcceptanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the risk acceptance is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state riskAcceptanceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.ExpirationDate.IsNull() || plan.ExpirationDate.IsUnknown() || plan.ExpirationDate.Equal(state.ExpirationDate) {
+		return
+	}
+
+	// the expiration date was already validated, so parsing can't fail
+	expirationDate, _ := time.Parse(time.DateOnly, plan.ExpirationDate.ValueString())
+	today, _ := time.Parse(time.DateOnly, time.Now().Format(time.DateOnly))
+	if !expirationDate.After(today) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiration_date"),
+			"Invalid Date",
+			"The expiration date "+plan.ExpirationDate.ValueString()+" is not in the future.",
+		)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *riskAcceptanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state riskAcceptanceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing risk acceptance
+	res, err := r.client.RiskAcceptanceAPI.RiskAcceptanceDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Risk Acceptance",
+			"Could not delete risk acceptance, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *riskAcceptanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// riskAcceptanceRequestFromPlan generates the request for creating and updating a risk acceptance.
+func riskAcceptanceRequestFromPlan(ctx context.Context, plan riskAcceptanceResourceModel) (defectdojo.RiskAcceptanceRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	acceptedFindings := make([]int32, 0)
+	diags.Append(plan.AcceptedFindings.ElementsAs(ctx, &acceptedFindings, true)...)
+
+	notes := make([]int32, 0)
+	diags.Append(plan.Notes.ElementsAs(ctx, &notes, true)...)
+
+	// the expiration date was already validated, so parsing can't fail
+	var expirationDate *time.Time
+	if !plan.ExpirationDate.IsNull() && !plan.ExpirationDate.IsUnknown() {
+		date, _ := time.Parse(time.DateOnly, plan.ExpirationDate.ValueString())
+		expirationDate = &date
+	}
+
+	return defectdojo.RiskAcceptanceRequest{
+		Name:                  plan.Name.ValueString(),
+		AcceptedFindings:      acceptedFindings,
+		Recommendation:        basetypesStringValueToStringPointer(plan.Recommendation),
+		RecommendationDetails: basetypesStringValueToDefectdojoNullableString(plan.RecommendationDetails),
+		Decision:              basetypesStringValueToStringPointer(plan.Decision),
+		DecisionDetails:       basetypesStringValueToDefectdojoNullableString(plan.DecisionDetails),
+		AcceptedBy:            basetypesStringValueToDefectdojoNullableString(plan.AcceptedBy),
+		Owner:                 int32(plan.Owner.ValueInt64()),
+		ExpirationDate:        *defectdojo.NewNullableTime(expirationDate),
+		ReactivateExpired:     basetypesBoolValueToBoolPointer(plan.ReactivateExpired),
+		RestartSlaExpired:     basetypesBoolValueToBoolPointer(plan.RestartSLAExpired),
+		Notes:                 notes,
+	}, diags
+}
+
+// mapRiskAcceptanceToModel maps a risk acceptance to the model.
+func mapRiskAcceptanceToModel(ctx context.Context, riskAcceptance *defectdojo.RiskAcceptance, model *riskAcceptanceResourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.Name = types.StringValue(riskAcceptance.GetName())
+	model.Recommendation = stringPointerToBasetypesStringValue(riskAcceptance.Recommendation)
+	model.RecommendationDetails = defectdojoNullableStringToBasetypesStringValue(riskAcceptance.RecommendationDetails)
+	model.Decision = stringPointerToBasetypesStringValue(riskAcceptance.Decision)
+	model.DecisionDetails = defectdojoNullableStringToBasetypesStringValue(riskAcceptance.DecisionDetails)
+	model.AcceptedBy = defectdojoNullableStringToBasetypesStringValue(riskAcceptance.AcceptedBy)
+	model.Owner = types.Int64Value(int64(riskAcceptance.GetOwner()))
+	model.ReactivateExpired = boolPointerToBasetypesBoolValue(riskAcceptance.ReactivateExpired)
+	model.RestartSLAExpired = boolPointerToBasetypesBoolValue(riskAcceptance.RestartSlaExpired)
+
+	// Defectdojo stores the expiration as a timestamp, but only the date is relevant
+	model.ExpirationDate = types.StringNull()
+	if expirationDate := riskAcceptance.ExpirationDate.Get(); expirationDate != nil {
+		model.ExpirationDate = types.StringValue(expirationDate.Format(time.DateOnly))
+	}
+
+	model.AcceptedFindings, d = types.ListValueFrom(ctx, types.Int64Type, riskAcceptance.AcceptedFindings)
+	diags.Append(d...)
+
+	model.Notes, d = types.ListValueFrom(ctx, types.Int64Type, riskAcceptance.Notes)
+	diags.Append(d...)
+
+	return diags
+}
+
+// findingEngagement returns the ID of the engagement a finding belongs to.
+func findingEngagement(ctx context.Context, client *defectdojo.APIClient, findingID int32) (int32, *http.Response, error) {
+	finding, res, err := client.FindingsAPI.FindingsRetrieve(ctx, findingID).Execute()
+	if err != nil {
+		return 0, res, err
+	}
+
+	test, res, err := client.TestsAPI.TestsRetrieve(ctx, finding.GetTest()).Execute()
+	if err != nil {
+		return 0, res, err
+	}
+
+	return test.GetEngagement(), res, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+// testAccFindingID returns the ID of an existing finding to accept.
+// the provider can't create findings, so the test is skipped without one.
+func testAccFindingID(t *testing.T) string {
+	v := os.Getenv("DEFECTDOJO_TEST_FINDING_ID")
+	if v == "" {
+		t.Skip("DEFECTDOJO_TEST_FINDING_ID must be set to the ID of an existing finding for this acceptance test")
+	}
+
+	return v
+}
+
+func TestAccRiskAcceptanceResource(t *testing.T) {
+	findingID := testAccFindingID(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "defectdojo_risk_acceptance" "test" {
+					name              = "Test Risk Acceptance"
+					accepted_findings = [%s]
+					decision          = "A"
+					decision_details  = "Approved in SEC-123"
+					owner             = 1
+					expiration_date   = "2099-12-31"
+				}
+			`, findingID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "name", "Test Risk Acceptance"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "accepted_findings.#", "1"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "accepted_findings.0", findingID),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "decision", "A"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "decision_details", "Approved in SEC-123"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "owner", "1"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "expiration_date", "2099-12-31"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_risk_acceptance.test", "id"),
+					resource.TestCheckResourceAttrSet("defectdojo_risk_acceptance.test", "engagement"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_risk_acceptance.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "defectdojo_risk_acceptance" "test" {
+					name                = "Test Risk Acceptance"
+					accepted_findings   = [%s]
+					recommendation      = "M"
+					decision            = "A"
+					decision_details    = "Approved in SEC-124"
+					accepted_by         = "CISO"
+					owner               = 1
+					expiration_date     = "2099-06-30"
+					reactivate_expired  = true
+					restart_sla_expired = true
+				}
+			`, findingID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "recommendation", "M"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "decision_details", "Approved in SEC-124"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "accepted_by", "CISO"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "expiration_date", "2099-06-30"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "reactivate_expired", "true"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "restart_sla_expired", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRiskAcceptanceResourceInvalidExpirationDate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "defectdojo_risk_acceptance" "test" {
+					name              = "Test Risk Acceptance"
+					accepted_findings = [1]
+					owner             = 1
+					expiration_date   = "31.12.2099"
+				}
+			`,
+				ExpectError: regexp.MustCompile("Invalid Date"),
+			},
+			// Expiration dates in the past would create an already expired risk acceptance
+			{
+				Config: providerConfig + `
+				resource "defectdojo_risk_acceptance" "test" {
+					name              = "Test Risk Acceptance"
+					accepted_findings = [1]
+					owner             = 1
+					expiration_date   = "2020-01-01"
+				}
+			`,
+				ExpectError: regexp.MustCompile("is not in the future"),
+			},
+		},
+	})
+}
+
+// testRiskAcceptanceModifyPlan plans a risk acceptance with the given expiration dates.
+// an empty state date plans the creation of the risk acceptance.
+func testRiskAcceptanceModifyPlan(t *testing.T, stateDate, planDate string) *fwresource.ModifyPlanResponse {
+	ctx := context.Background()
+	r := NewRiskAcceptanceResource().(fwresource.ResourceWithModifyPlan)
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	riskAcceptance := func(expirationDate string) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["expiration_date"] = tftypes.NewValue(tftypes.String, expirationDate)
+
+		return tftypes.NewValue(objectType, values)
+	}
+
+	state := tftypes.NewValue(objectType, nil)
+	if stateDate != "" {
+		state = riskAcceptance(stateDate)
+	}
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: riskAcceptance(planDate)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: riskAcceptance(planDate)},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+
+	return resp
+}
+
+func TestUnitRiskAcceptanceModifyPlanExpirationDate(t *testing.T) {
+	yesterday := time.Now().AddDate(0, 0, -1).Format(time.DateOnly)
+	today := time.Now().Format(time.DateOnly)
+
+	// an already expired risk acceptance can still be refreshed and planned
+	require.False(t, testRiskAcceptanceModifyPlan(t, "2020-01-01", "2020-01-01").Diagnostics.HasError())
+
+	// moving the expiration date into the future renews the risk acceptance
+	require.False(t, testRiskAcceptanceModifyPlan(t, "2020-01-01", "2099-12-31").Diagnostics.HasError())
+	require.False(t, testRiskAcceptanceModifyPlan(t, "", "2099-12-31").Diagnostics.HasError())
+
+	// new expiration dates have to be in the future
+	require.True(t, testRiskAcceptanceModifyPlan(t, "", yesterday).Diagnostics.HasError())
+	require.True(t, testRiskAcceptanceModifyPlan(t, "", today).Diagnostics.HasError())
+	require.True(t, testRiskAcceptanceModifyPlan(t, "2099-12-31", "2020-01-01").Diagnostics.HasError())
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SLAConfigurationDataSource{}
+	_ datasource.DataSourceWithConfigure = &SLAConfigurationDataSource{}
+)
+
+func NewSLAConfigurationDataSource() datasource.DataSource {
+	return &SLAConfigurationDataSource{}
+}
+
+// SLAConfigurationDataSource defines the data source implementation.
+type SLAConfigurationDataSource struct {
+	client *defectdojo.APIClient
+}
+
+// SLAConfigurationDataSourceModel describes the data source data model.
+type SLAConfigurationDataSourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Critical        types.Int64  `tfsdk:"critical"`
+	EnforceCritical types.Bool   `tfsdk:"enforce_critical"`
+	High            types.Int64  `tfsdk:"high"`
+	EnforceHigh     types.Bool   `tfsdk:"enforce_high"`
+	Medium          types.Int64  `tfsdk:"medium"`
+	EnforceMedium   types.Bool   `tfsdk:"enforce_medium"`
+	Low             types.Int64  `tfsdk:"low"`
+	EnforceLow      types.Bool   `tfsdk:"enforce_low"`
+}
+
+// Metadata returns the data source type name.
+func (d *SLAConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_configuration"
+}
+
+// Schema defines the schema for the data source.
+func (d *SLAConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the SLA configuration",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the SLA configuration to look up",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the SLA configuration",
+				Computed:    true,
+			},
+			"critical": schema.Int64Attribute{
+				Description: "The number of days to remediate a critical finding",
+				Computed:    true,
+			},
+			"enforce_critical": schema.BoolAttribute{
+				Description: "Whether critical findings are assigned an SLA expiration date",
+				Computed:    true,
+			},
+			"high": schema.Int64Attribute{
+				Description: "The number of days to remediate a high finding",
+				Computed:    true,
+			},
+			"enforce_high": schema.BoolAttribute{
+				Description: "Whether high findings are assigned an SLA expiration date",
+				Computed:    true,
+			},
+			"medium": schema.Int64Attribute{
+				Description: "The number of days to remediate a medium finding",
+				Computed:    true,
+			},
+			"enforce_medium": schema.BoolAttribute{
+				Description: "Whether medium findings are assigned an SLA expiration date",
+				Computed:    true,
+			},
+			"low": schema.Int64Attribute{
+				Description: "The number of days to remediate a low finding",
+				Computed:    true,
+			},
+			"enforce_low": schema.BoolAttribute{
+				Description: "Whether low findings are assigned an SLA expiration date",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SLAConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SLAConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SLAConfigurationDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slaConfiguration, res, err := findInPages(func(offset int32) ([]defectdojo.SLAConfiguration, bool, *http.Response, error) {
+		slaConfigurations, res, err := d.client.SlaConfigurationsAPI.SlaConfigurationsList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return slaConfigurations.Results, slaConfigurations.Next.Get() != nil, res, nil
+	}, func(slaConfiguration *defectdojo.SLAConfiguration) bool {
+		return slaConfiguration.GetName() == state.Name.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read SLA Configurations",
+			"Could not read SLA configurations, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	if slaConfiguration == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"SLA Configuration Not Found",
+			"Could not find an SLA configuration with the name "+state.Name.String(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.Int64Value(int64(slaConfiguration.GetId()))
+	state.Description = defectdojoNullableStringToBasetypesStringValue(slaConfiguration.Description)
+	state.Critical = int32PointerToBasetypesInt64Value(slaConfiguration.Critical)
+	state.EnforceCritical = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceCritical)
+	state.High = int32PointerToBasetypesInt64Value(slaConfiguration.High)
+	state.EnforceHigh = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceHigh)
+	state.Medium = int32PointerToBasetypesInt64Value(slaConfiguration.Medium)
+	state.EnforceMedium = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceMedium)
+	state.Low = int32PointerToBasetypesInt64Value(slaConfiguration.Low)
+	state.EnforceLow = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceLow)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSLAConfigurationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_sla_configuration" "test" {
+					name     = "Test SLA Configuration Lookup"
+					critical = 5
+				}
+
+				data "defectdojo_sla_configuration" "test" {
+					name = defectdojo_sla_configuration.test.name
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the SLA configuration was found by its name
+					resource.TestCheckResourceAttrPair("data.defectdojo_sla_configuration.test", "id", "defectdojo_sla_configuration.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_sla_configuration.test", "critical", "5"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &slaConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &slaConfigurationResource{}
+	_ resource.ResourceWithImportState = &slaConfigurationResource{}
+)
+
+// NewSLAConfigurationResource is a helper function to simplify the provider implementation.
+func NewSLAConfigurationResource() resource.Resource {
+	return &slaConfigurationResource{}
+}
+
+// slaConfigurationResource is the resource implementation.
+type slaConfigurationResource struct {
+	client *defectdojo.APIClient
+}
+
+type slaConfigurationResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Critical        types.Int64  `tfsdk:"critical"`
+	EnforceCritical types.Bool   `tfsdk:"enforce_critical"`
+	High            types.Int64  `tfsdk:"high"`
+	EnforceHigh     types.Bool   `tfsdk:"enforce_high"`
+	Medium          types.Int64  `tfsdk:"medium"`
+	EnforceMedium   types.Bool   `tfsdk:"enforce_medium"`
+	Low             types.Int64  `tfsdk:"low"`
+	EnforceLow      types.Bool   `tfsdk:"enforce_low"`
+}
+
+// Metadata returns the resource type name.
+func (r *slaConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_configuration"
+}
+
+// Schema defines the schema for the resource.
+func (r *slaConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the SLA configuration",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique name for the set of SLAs",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the SLA configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+			"critical": schema.Int64Attribute{
+				Description: "The number of days to remediate a critical finding",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enforce_critical": schema.BoolAttribute{
+				Description: "When enabled, critical findings will be assigned an SLA expiration date based on the critical finding SLA days within this SLA configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+			"high": schema.Int64Attribute{
+				Description: "The number of days to remediate a high finding",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enforce_high": schema.BoolAttribute{
+				Description: "When enabled, high findings will be assigned an SLA expiration date based on the high finding SLA days within this SLA configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+			"medium": schema.Int64Attribute{
+				Description: "The number of days to remediate a medium finding",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enforce_medium": schema.BoolAttribute{
+				Description: "When enabled, medium findings will be assigned an SLA expiration date based on the medium finding SLA days within this SLA configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+			"low": schema.Int64Attribute{
+				Description: "The number of days to remediate a low finding",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enforce_low": schema.BoolAttribute{
+				Description: "When enabled, low findings will be assigned an SLA expiration date based on the low finding SLA days within this SLA configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *slaConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *slaConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan slaConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	slaConfigurationRequest := defectdojo.SLAConfigurationRequest{
+		Name:            plan.Name.ValueString(),
+		Description:     basetypesStringValueToDefectdojoNullableString(plan.Description),
+		Critical:        basetypesInt64ValueToInt32Pointer(plan.Critical),
+		EnforceCritical: basetypesBoolValueToBoolPointer(plan.EnforceCritical),
+		High:            basetypesInt64ValueToInt32Pointer(plan.High),
+		EnforceHigh:     basetypesBoolValueToBoolPointer(plan.EnforceHigh),
+		Medium:          basetypesInt64ValueToInt32Pointer(plan.Medium),
+		EnforceMedium:   basetypesBoolValueToBoolPointer(plan.EnforceMedium),
+		Low:             basetypesInt64ValueToInt32Pointer(plan.Low),
+		EnforceLow:      basetypesBoolValueToBoolPointer(plan.EnforceLow),
+	}
+
+	// Create new SLA configuration
+	slaConfiguration, res, err := r.client.SlaConfigurationsAPI.SlaConfigurationsCreate(ctx).SLAConfigurationRequest(slaConfigurationRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo SLA Configuration",
+			"Could not create SLA configuration, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(slaConfiguration.GetId()))
+	plan.Name = types.StringValue(slaConfiguration.GetName())
+	plan.Description = defectdojoNullableStringToBasetypesStringValue(slaConfiguration.Description)
+	plan.Critical = int32PointerToBasetypesInt64Value(slaConfiguration.Critical)
+	plan.EnforceCritical = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceCritical)
+	plan.High = int32PointerToBasetypesInt64Value(slaConfiguration.High)
+	plan.EnforceHigh = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceHigh)
+	plan.Medium = int32PointerToBasetypesInt64Value(slaConfiguration.Medium)
+	plan.EnforceMedium = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceMedium)
+	plan.Low = int32PointerToBasetypesInt64Value(slaConfiguration.Low)
+	plan.EnforceLow = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceLow)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *slaConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state slaConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed SLA configuration value from Defectdojo
+	slaConfiguration, res, err := r.client.SlaConfigurationsAPI.SlaConfigurationsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo SLA Configuration",
+			"Could not read SLA configuration with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(slaConfiguration.GetId()))
+	state.Name = types.StringValue(slaConfiguration.GetName())
+	state.Description = defectdojoNullableStringToBasetypesStringValue(slaConfiguration.Description)
+	state.Critical = int32PointerToBasetypesInt64Value(slaConfiguration.Critical)
+	state.EnforceCritical = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceCritical)
+	state.High = int32PointerToBasetypesInt64Value(slaConfiguration.High)
+	state.EnforceHigh = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceHigh)
+	state.Medium = int32PointerToBasetypesInt64Value(slaConfiguration.Medium)
+	state.EnforceMedium = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceMedium)
+	state.Low = int32PointerToBasetypesInt64Value(slaConfiguration.Low)
+	state.EnforceLow = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceLow)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *slaConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan slaConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	slaConfigurationRequest := defectdojo.SLAConfigurationRequest{
+		Name:            plan.Name.ValueString(),
+		Description:     basetypesStringValueToDefectdojoNullableString(plan.Description),
+		Critical:        basetypesInt64ValueToInt32Pointer(plan.Critical),
+		EnforceCritical: basetypesBoolValueToBoolPointer(plan.EnforceCritical),
+		High:            basetypesInt64ValueToInt32Pointer(plan.High),
+		EnforceHigh:     basetypesBoolValueToBoolPointer(plan.EnforceHigh),
+		Medium:          basetypesInt64ValueToInt32Pointer(plan.Medium),
+		EnforceMedium:   basetypesBoolValueToBoolPointer(plan.EnforceMedium),
+		Low:             basetypesInt64ValueToInt32Pointer(plan.Low),
+		EnforceLow:      basetypesBoolValueToBoolPointer(plan.EnforceLow),
+	}
+
+	// Update existing SLA configuration
+	_, res, err := r.client.SlaConfigurationsAPI.SlaConfigurationsUpdate(ctx, int32(plan.ID.ValueInt64())).SLAConfigurationRequest(slaConfigurationRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo SLA Configuration",
+			"Could not update SLA configuration with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed SLA configuration value from Defectdojo
+	slaConfiguration, res, err := r.client.SlaConfigurationsAPI.SlaConfigurationsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo SLA Configuration",
+			"Could not read SLA configuration with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(slaConfiguration.GetId()))
+	plan.Name = types.StringValue(slaConfiguration.GetName())
+	plan.Description = defectdojoNullableStringToBasetypesStringValue(slaConfiguration.Description)
+	plan.Critical = int32PointerToBasetypesInt64Value(slaConfiguration.Critical)
+	plan.EnforceCritical = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceCritical)
+	plan.High = int32PointerToBasetypesInt64Value(slaConfiguration.High)
+	plan.EnforceHigh = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceHigh)
+	plan.Medium = int32PointerToBasetypesInt64Value(slaConfiguration.Medium)
+	plan.EnforceMedium = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceMedium)
+	plan.Low = int32PointerToBasetypesInt64Value(slaConfiguration.Low)
+	plan.EnforceLow = boolPointerToBasetypesBoolValue(slaConfiguration.EnforceLow)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *slaConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state slaConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing SLA configuration
+	res, err := r.client.SlaConfigurationsAPI.SlaConfigurationsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo SLA Configuration",
+			"Could not delete SLA configuration, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *slaConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
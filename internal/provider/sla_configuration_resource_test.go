@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSLAConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_sla_configuration" "test" {
+					name        = "Test SLA Configuration"
+					description = "This is the description of the Test SLA Configuration"
+					critical    = 3
+					high        = 14
+					medium      = 60
+					low         = 90
+					enforce_low = false
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "name", "Test SLA Configuration"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "description", "This is the description of the Test SLA Configuration"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "critical", "3"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "high", "14"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "medium", "60"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "low", "90"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "enforce_low", "false"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_sla_configuration.test", "id"),
+					resource.TestCheckResourceAttrSet("defectdojo_sla_configuration.test", "enforce_critical"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_sla_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_sla_configuration" "test" {
+					name        = "Test SLA Configuration Updated"
+					description = "This is the description of the Test SLA Configuration"
+					critical    = 1
+					high        = 7
+					medium      = 60
+					low         = 90
+					enforce_low = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "name", "Test SLA Configuration Updated"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "critical", "1"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "high", "7"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "enforce_low", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,728 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &systemSettingsResource{}
+	_ resource.ResourceWithConfigure   = &systemSettingsResource{}
+	_ resource.ResourceWithImportState = &systemSettingsResource{}
+)
+
+// NewSystemSettingsResource is a helper function to simplify the provider implementation.
+func NewSystemSettingsResource() resource.Resource {
+	return &systemSettingsResource{}
+}
+
+// systemSettingsResource is the resource implementation.
+// Defectdojo has exactly one system settings record, which can't be created or deleted.
+type systemSettingsResource struct {
+	client *defectdojo.APIClient
+}
+
+type systemSettingsResourceModel struct {
+	ID                                   types.Int64  `tfsdk:"id"`
+	EnableDeduplication                  types.Bool   `tfsdk:"enable_deduplication"`
+	DeleteDuplicates                     types.Bool   `tfsdk:"delete_duplicates"`
+	MaxDupes                             types.Int64  `tfsdk:"max_dupes"`
+	FalsePositiveHistory                 types.Bool   `tfsdk:"false_positive_history"`
+	RetroactiveFalsePositiveHistory      types.Bool   `tfsdk:"retroactive_false_positive_history"`
+	EmailFrom                            types.String `tfsdk:"email_from"`
+	URLPrefix                            types.String `tfsdk:"url_prefix"`
+	TeamName                             types.String `tfsdk:"team_name"`
+	EnableJira                           types.Bool   `tfsdk:"enable_jira"`
+	EnableJiraWebHook                    types.Bool   `tfsdk:"enable_jira_web_hook"`
+	DisableJiraWebhookSecret             types.Bool   `tfsdk:"disable_jira_webhook_secret"`
+	JiraMinimumSeverity                  types.String `tfsdk:"jira_minimum_severity"`
+	JiraLabels                           types.String `tfsdk:"jira_labels"`
+	AddVulnerabilityIDToJiraLabel        types.Bool   `tfsdk:"add_vulnerability_id_to_jira_label"`
+	EnableGithub                         types.Bool   `tfsdk:"enable_github"`
+	EnableSlackNotifications             types.Bool   `tfsdk:"enable_slack_notifications"`
+	SlackChannel                         types.String `tfsdk:"slack_channel"`
+	SlackUsername                        types.String `tfsdk:"slack_username"`
+	EnableMSTeamsNotifications           types.Bool   `tfsdk:"enable_msteams_notifications"`
+	MSTeamsURL                           types.String `tfsdk:"msteams_url"`
+	EnableMailNotifications              types.Bool   `tfsdk:"enable_mail_notifications"`
+	MailNotificationsTo                  types.String `tfsdk:"mail_notifications_to"`
+	EnableWebhooksNotifications          types.Bool   `tfsdk:"enable_webhooks_notifications"`
+	EnableFindingSLA                     types.Bool   `tfsdk:"enable_finding_sla"`
+	EnableNotifySLAActive                types.Bool   `tfsdk:"enable_notify_sla_active"`
+	EnableNotifySLAActiveVerified        types.Bool   `tfsdk:"enable_notify_sla_active_verified"`
+	EnableNotifySLAJiraOnly              types.Bool   `tfsdk:"enable_notify_sla_jira_only"`
+	EnableNotifySLAExponentialBackoff    types.Bool   `tfsdk:"enable_notify_sla_exponential_backoff"`
+	RiskAcceptanceFormDefaultDays        types.Int64  `tfsdk:"risk_acceptance_form_default_days"`
+	RiskAcceptanceNotifyBeforeExpiration types.Int64  `tfsdk:"risk_acceptance_notify_before_expiration"`
+	EnableProductGrade                   types.Bool   `tfsdk:"enable_product_grade"`
+	ProductGradeA                        types.Int64  `tfsdk:"product_grade_a"`
+	ProductGradeB                        types.Int64  `tfsdk:"product_grade_b"`
+	ProductGradeC                        types.Int64  `tfsdk:"product_grade_c"`
+	ProductGradeD                        types.Int64  `tfsdk:"product_grade_d"`
+	ProductGradeF                        types.Int64  `tfsdk:"product_grade_f"`
+	EnableProductTagInheritance          types.Bool   `tfsdk:"enable_product_tag_inheritance"`
+	EnableBenchmark                      types.Bool   `tfsdk:"enable_benchmark"`
+	EnableTemplateMatch                  types.Bool   `tfsdk:"enable_template_match"`
+	EnableSimilarFindings                types.Bool   `tfsdk:"enable_similar_findings"`
+	EnableCalendar                       types.Bool   `tfsdk:"enable_calendar"`
+	EnableQuestionnaires                 types.Bool   `tfsdk:"enable_questionnaires"`
+	EnableChecklists                     types.Bool   `tfsdk:"enable_checklists"`
+	EnableCredentials                    types.Bool   `tfsdk:"enable_credentials"`
+	EnableEndpointMetadataImport         types.Bool   `tfsdk:"enable_endpoint_metadata_import"`
+	EnableUserProfileEditable            types.Bool   `tfsdk:"enable_user_profile_editable"`
+	DefaultGroup                         types.Int64  `tfsdk:"default_group"`
+	DefaultGroupRole                     types.Int64  `tfsdk:"default_group_role"`
+	DefaultGroupEmailPattern             types.String `tfsdk:"default_group_email_pattern"`
+	MinimumPasswordLength                types.Int64  `tfsdk:"minimum_password_length"`
+	MaximumPasswordLength                types.Int64  `tfsdk:"maximum_password_length"`
+	NumberCharacterRequired              types.Bool   `tfsdk:"number_character_required"`
+	SpecialCharacterRequired             types.Bool   `tfsdk:"special_character_required"`
+	LowercaseCharacterRequired           types.Bool   `tfsdk:"lowercase_character_required"`
+	UppercaseCharacterRequired           types.Bool   `tfsdk:"uppercase_character_required"`
+	NonCommonPasswordRequired            types.Bool   `tfsdk:"non_common_password_required"`
+}
+
+// Metadata returns the resource type name.
+func (r *systemSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *systemSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the system settings of Defectdojo. The system settings always exist, so creating this resource adopts them and destroying it only removes it from the state. Only configured attributes are enforced",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the system settings",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_deduplication": schema.BoolAttribute{
+				Description: "Whether findings are deduplicated",
+				Computed:    true,
+				Optional:    true,
+			},
+			"delete_duplicates": schema.BoolAttribute{
+				Description: "Whether duplicate findings are deleted once max_dupes is reached",
+				Computed:    true,
+				Optional:    true,
+			},
+			"max_dupes": schema.Int64Attribute{
+				Description: "The maximum number of duplicates to keep for a finding when delete_duplicates is enabled",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"false_positive_history": schema.BoolAttribute{
+				Description: "Whether new findings matching a finding previously marked as false positive are marked as false positive as well",
+				Computed:    true,
+				Optional:    true,
+			},
+			"retroactive_false_positive_history": schema.BoolAttribute{
+				Description: "Whether existing findings matching a newly marked false positive are marked as false positive as well, requires false_positive_history",
+				Computed:    true,
+				Optional:    true,
+			},
+			"email_from": schema.StringAttribute{
+				Description: "The sender address of emails sent by Defectdojo",
+				Computed:    true,
+				Optional:    true,
+			},
+			"url_prefix": schema.StringAttribute{
+				Description: "The URL prefix of Defectdojo if it is not served at the root",
+				Computed:    true,
+				Optional:    true,
+			},
+			"team_name": schema.StringAttribute{
+				Description: "The name of the team using Defectdojo",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_jira": schema.BoolAttribute{
+				Description: "Whether the JIRA integration is enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_jira_web_hook": schema.BoolAttribute{
+				Description: "Whether the JIRA web hook is enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"disable_jira_webhook_secret": schema.BoolAttribute{
+				Description: "Whether the JIRA web hook can be used without a secret",
+				Computed:    true,
+				Optional:    true,
+			},
+			"jira_minimum_severity": schema.StringAttribute{
+				Description: "The minimum severity of findings that are pushed to JIRA, one of Critical, High, Medium, Low or Info",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Critical", "High", "Medium", "Low", "Info"),
+				},
+			},
+			"jira_labels": schema.StringAttribute{
+				Description: "The labels added to JIRA issues, separated by spaces",
+				Computed:    true,
+				Optional:    true,
+			},
+			"add_vulnerability_id_to_jira_label": schema.BoolAttribute{
+				Description: "Whether the vulnerability IDs of a finding are added as labels to its JIRA issue",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_github": schema.BoolAttribute{
+				Description: "Whether the GitHub integration is enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_slack_notifications": schema.BoolAttribute{
+				Description: "Whether notifications can be sent to Slack",
+				Computed:    true,
+				Optional:    true,
+			},
+			"slack_channel": schema.StringAttribute{
+				Description: "The Slack channel notifications are sent to",
+				Computed:    true,
+				Optional:    true,
+			},
+			"slack_username": schema.StringAttribute{
+				Description: "The username notifications are sent to Slack as",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_msteams_notifications": schema.BoolAttribute{
+				Description: "Whether notifications can be sent to Microsoft Teams",
+				Computed:    true,
+				Optional:    true,
+			},
+			"msteams_url": schema.StringAttribute{
+				Description: "The incoming webhook URL of the Microsoft Teams channel notifications are sent to",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_mail_notifications": schema.BoolAttribute{
+				Description: "Whether notifications can be sent by mail",
+				Computed:    true,
+				Optional:    true,
+			},
+			"mail_notifications_to": schema.StringAttribute{
+				Description: "The email address notifications are sent to",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_webhooks_notifications": schema.BoolAttribute{
+				Description: "Whether notifications can be sent to webhooks",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_finding_sla": schema.BoolAttribute{
+				Description: "Whether findings are assigned an SLA expiration date",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_notify_sla_active": schema.BoolAttribute{
+				Description: "Whether SLA notifications are sent for active findings",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_notify_sla_active_verified": schema.BoolAttribute{
+				Description: "Whether SLA notifications are sent for active and verified findings",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_notify_sla_jira_only": schema.BoolAttribute{
+				Description: "Whether SLA notifications are only sent for findings with a JIRA issue",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_notify_sla_exponential_backoff": schema.BoolAttribute{
+				Description: "Whether SLA breach notifications are sent with an exponential backoff instead of daily",
+				Computed:    true,
+				Optional:    true,
+			},
+			"risk_acceptance_form_default_days": schema.Int64Attribute{
+				Description: "The default number of days until a risk acceptance expires",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"risk_acceptance_notify_before_expiration": schema.Int64Attribute{
+				Description: "The number of days before the expiration of a risk acceptance a notification is sent",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"enable_product_grade": schema.BoolAttribute{
+				Description: "Whether products are graded",
+				Computed:    true,
+				Optional:    true,
+			},
+			"product_grade_a": schema.Int64Attribute{
+				Description: "The minimum score of a product to be graded A",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"product_grade_b": schema.Int64Attribute{
+				Description: "The minimum score of a product to be graded B",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"product_grade_c": schema.Int64Attribute{
+				Description: "The minimum score of a product to be graded C",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"product_grade_d": schema.Int64Attribute{
+				Description: "The minimum score of a product to be graded D",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"product_grade_f": schema.Int64Attribute{
+				Description: "The maximum score of a product to be graded F",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"enable_product_tag_inheritance": schema.BoolAttribute{
+				Description: "Whether the tags of a product are inherited by its engagements, tests, findings and endpoints",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_benchmark": schema.BoolAttribute{
+				Description: "Whether the benchmarks are enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_template_match": schema.BoolAttribute{
+				Description: "Whether findings are matched against finding templates",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_similar_findings": schema.BoolAttribute{
+				Description: "Whether similar findings are shown",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_calendar": schema.BoolAttribute{
+				Description: "Whether the calendar is enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_questionnaires": schema.BoolAttribute{
+				Description: "Whether questionnaires are enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_checklists": schema.BoolAttribute{
+				Description: "Whether checklists are enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_credentials": schema.BoolAttribute{
+				Description: "Whether the credential manager is enabled",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_endpoint_metadata_import": schema.BoolAttribute{
+				Description: "Whether endpoint metadata can be imported",
+				Computed:    true,
+				Optional:    true,
+			},
+			"enable_user_profile_editable": schema.BoolAttribute{
+				Description: "Whether users can edit their own profile",
+				Computed:    true,
+				Optional:    true,
+			},
+			"default_group": schema.Int64Attribute{
+				Description: "The ID of the group new users are added to",
+				Computed:    true,
+				Optional:    true,
+			},
+			"default_group_role": schema.Int64Attribute{
+				Description: "The ID of the role new users get in the default group",
+				Computed:    true,
+				Optional:    true,
+			},
+			"default_group_email_pattern": schema.StringAttribute{
+				Description: "A regular expression the email address of new users must match to be added to the default group",
+				Computed:    true,
+				Optional:    true,
+			},
+			"minimum_password_length": schema.Int64Attribute{
+				Description: "The minimum length of user passwords",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"maximum_password_length": schema.Int64Attribute{
+				Description: "The maximum length of user passwords",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"number_character_required": schema.BoolAttribute{
+				Description: "Whether user passwords must contain a number",
+				Computed:    true,
+				Optional:    true,
+			},
+			"special_character_required": schema.BoolAttribute{
+				Description: "Whether user passwords must contain a special character",
+				Computed:    true,
+				Optional:    true,
+			},
+			"lowercase_character_required": schema.BoolAttribute{
+				Description: "Whether user passwords must contain a lowercase letter",
+				Computed:    true,
+				Optional:    true,
+			},
+			"uppercase_character_required": schema.BoolAttribute{
+				Description: "Whether user passwords must contain an uppercase letter",
+				Computed:    true,
+				Optional:    true,
+			},
+			"non_common_password_required": schema.BoolAttribute{
+				Description: "Whether user passwords must not be a commonly used password",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *systemSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create adopts the existing system settings and sets the initial Terraform state.
+func (r *systemSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan systemSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the existing system settings
+	systemSettingsList, res, err := r.client.SystemSettingsAPI.SystemSettingsList(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo System Settings",
+			"Could not read system settings, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	if len(systemSettingsList.Results) == 0 {
+		resp.Diagnostics.AddError(
+			"Defectdojo System Settings Not Found",
+			"Defectdojo did not return any system settings.",
+		)
+		return
+	}
+
+	// Apply the configured values to the existing system settings
+	id := systemSettingsList.Results[0].GetId()
+	systemSettings, res, err := r.client.SystemSettingsAPI.SystemSettingsPartialUpdate(ctx, id).PatchedSystemSettingsRequest(systemSettingsRequestFromPlan(plan)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo System Settings",
+			"Could not update system settings with ID "+strconv.Itoa(int(id))+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(systemSettings.GetId()))
+	mapSystemSettingsToModel(systemSettings, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *systemSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state systemSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed system settings value from Defectdojo
+	systemSettings, res, err := r.client.SystemSettingsAPI.SystemSettingsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo System Settings",
+			"Could not read system settings with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(systemSettings.GetId()))
+	mapSystemSettingsToModel(systemSettings, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *systemSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan systemSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing system settings
+	systemSettings, res, err := r.client.SystemSettingsAPI.SystemSettingsPartialUpdate(ctx, int32(plan.ID.ValueInt64())).PatchedSystemSettingsRequest(systemSettingsRequestFromPlan(plan)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo System Settings",
+			"Could not update system settings with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(systemSettings.GetId()))
+	mapSystemSettingsToModel(systemSettings, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Terraform state.
+// the system settings can't be deleted, so they are left as they are.
+func (r *systemSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *systemSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// systemSettingsRequestFromPlan generates the partial update request for the system settings.
+// unknown values are left out, so only the configured and previously known values are sent.
+func systemSettingsRequestFromPlan(plan systemSettingsResourceModel) defectdojo.PatchedSystemSettingsRequest {
+	systemSettingsRequest := defectdojo.PatchedSystemSettingsRequest{
+		EnableDeduplication:               basetypesBoolValueToBoolPointer(plan.EnableDeduplication),
+		DeleteDuplicates:                  basetypesBoolValueToBoolPointer(plan.DeleteDuplicates),
+		FalsePositiveHistory:              basetypesBoolValueToBoolPointer(plan.FalsePositiveHistory),
+		RetroactiveFalsePositiveHistory:   basetypesBoolValueToBoolPointer(plan.RetroactiveFalsePositiveHistory),
+		EmailFrom:                         basetypesStringValueToStringPointer(plan.EmailFrom),
+		UrlPrefix:                         basetypesStringValueToStringPointer(plan.URLPrefix),
+		TeamName:                          basetypesStringValueToStringPointer(plan.TeamName),
+		EnableJira:                        basetypesBoolValueToBoolPointer(plan.EnableJira),
+		EnableJiraWebHook:                 basetypesBoolValueToBoolPointer(plan.EnableJiraWebHook),
+		DisableJiraWebhookSecret:          basetypesBoolValueToBoolPointer(plan.DisableJiraWebhookSecret),
+		AddVulnerabilityIdToJiraLabel:     basetypesBoolValueToBoolPointer(plan.AddVulnerabilityIDToJiraLabel),
+		EnableGithub:                      basetypesBoolValueToBoolPointer(plan.EnableGithub),
+		EnableSlackNotifications:          basetypesBoolValueToBoolPointer(plan.EnableSlackNotifications),
+		SlackChannel:                      basetypesStringValueToStringPointer(plan.SlackChannel),
+		SlackUsername:                     basetypesStringValueToStringPointer(plan.SlackUsername),
+		EnableMsteamsNotifications:        basetypesBoolValueToBoolPointer(plan.EnableMSTeamsNotifications),
+		MsteamsUrl:                        basetypesStringValueToStringPointer(plan.MSTeamsURL),
+		EnableMailNotifications:           basetypesBoolValueToBoolPointer(plan.EnableMailNotifications),
+		MailNotificationsTo:               basetypesStringValueToStringPointer(plan.MailNotificationsTo),
+		EnableWebhooksNotifications:       basetypesBoolValueToBoolPointer(plan.EnableWebhooksNotifications),
+		EnableFindingSla:                  basetypesBoolValueToBoolPointer(plan.EnableFindingSLA),
+		EnableNotifySlaActive:             basetypesBoolValueToBoolPointer(plan.EnableNotifySLAActive),
+		EnableNotifySlaActiveVerified:     basetypesBoolValueToBoolPointer(plan.EnableNotifySLAActiveVerified),
+		EnableNotifySlaJiraOnly:           basetypesBoolValueToBoolPointer(plan.EnableNotifySLAJiraOnly),
+		EnableNotifySlaExponentialBackoff: basetypesBoolValueToBoolPointer(plan.EnableNotifySLAExponentialBackoff),
+		EnableProductGrade:                basetypesBoolValueToBoolPointer(plan.EnableProductGrade),
+		ProductGradeA:                     basetypesInt64ValueToInt32Pointer(plan.ProductGradeA),
+		ProductGradeB:                     basetypesInt64ValueToInt32Pointer(plan.ProductGradeB),
+		ProductGradeC:                     basetypesInt64ValueToInt32Pointer(plan.ProductGradeC),
+		ProductGradeD:                     basetypesInt64ValueToInt32Pointer(plan.ProductGradeD),
+		ProductGradeF:                     basetypesInt64ValueToInt32Pointer(plan.ProductGradeF),
+		EnableProductTagInheritance:       basetypesBoolValueToBoolPointer(plan.EnableProductTagInheritance),
+		EnableBenchmark:                   basetypesBoolValueToBoolPointer(plan.EnableBenchmark),
+		EnableTemplateMatch:               basetypesBoolValueToBoolPointer(plan.EnableTemplateMatch),
+		EnableSimilarFindings:             basetypesBoolValueToBoolPointer(plan.EnableSimilarFindings),
+		EnableCalendar:                    basetypesBoolValueToBoolPointer(plan.EnableCalendar),
+		EnableQuestionnaires:              basetypesBoolValueToBoolPointer(plan.EnableQuestionnaires),
+		EnableChecklists:                  basetypesBoolValueToBoolPointer(plan.EnableChecklists),
+		EnableCredentials:                 basetypesBoolValueToBoolPointer(plan.EnableCredentials),
+		EnableEndpointMetadataImport:      basetypesBoolValueToBoolPointer(plan.EnableEndpointMetadataImport),
+		EnableUserProfileEditable:         basetypesBoolValueToBoolPointer(plan.EnableUserProfileEditable),
+		MinimumPasswordLength:             basetypesInt64ValueToInt32Pointer(plan.MinimumPasswordLength),
+		MaximumPasswordLength:             basetypesInt64ValueToInt32Pointer(plan.MaximumPasswordLength),
+		NumberCharacterRequired:           basetypesBoolValueToBoolPointer(plan.NumberCharacterRequired),
+		SpecialCharacterRequired:          basetypesBoolValueToBoolPointer(plan.SpecialCharacterRequired),
+		LowercaseCharacterRequired:        basetypesBoolValueToBoolPointer(plan.LowercaseCharacterRequired),
+		UppercaseCharacterRequired:        basetypesBoolValueToBoolPointer(plan.UppercaseCharacterRequired),
+		NonCommonPasswordRequired:         basetypesBoolValueToBoolPointer(plan.NonCommonPasswordRequired),
+	}
+
+	// nullable fields are only set when known, as an unset field is left out of the request
+	if !plan.MaxDupes.IsUnknown() {
+		systemSettingsRequest.MaxDupes = basetypesInt64ValueToDefectdojoNullableInt32(plan.MaxDupes)
+	}
+	if !plan.JiraMinimumSeverity.IsUnknown() {
+		systemSettingsRequest.JiraMinimumSeverity = basetypesStringValueToDefectdojoNullableString(plan.JiraMinimumSeverity)
+	}
+	if !plan.JiraLabels.IsUnknown() {
+		systemSettingsRequest.JiraLabels = basetypesStringValueToDefectdojoNullableString(plan.JiraLabels)
+	}
+	if !plan.RiskAcceptanceFormDefaultDays.IsUnknown() {
+		systemSettingsRequest.RiskAcceptanceFormDefaultDays = basetypesInt64ValueToDefectdojoNullableInt32(plan.RiskAcceptanceFormDefaultDays)
+	}
+	if !plan.RiskAcceptanceNotifyBeforeExpiration.IsUnknown() {
+		systemSettingsRequest.RiskAcceptanceNotifyBeforeExpiration = basetypesInt64ValueToDefectdojoNullableInt32(plan.RiskAcceptanceNotifyBeforeExpiration)
+	}
+	if !plan.DefaultGroup.IsUnknown() {
+		systemSettingsRequest.DefaultGroup = basetypesInt64ValueToDefectdojoNullableInt32(plan.DefaultGroup)
+	}
+	if !plan.DefaultGroupRole.IsUnknown() {
+		systemSettingsRequest.DefaultGroupRole = basetypesInt64ValueToDefectdojoNullableInt32(plan.DefaultGroupRole)
+	}
+	if !plan.DefaultGroupEmailPattern.IsUnknown() {
+		systemSettingsRequest.DefaultGroupEmailPattern = basetypesStringValueToDefectdojoNullableString(plan.DefaultGroupEmailPattern)
+	}
+
+	return systemSettingsRequest
+}
+
+// mapSystemSettingsToModel maps the system settings to the model.
+func mapSystemSettingsToModel(systemSettings *defectdojo.SystemSettings, model *systemSettingsResourceModel) {
+	model.EnableDeduplication = boolPointerToBasetypesBoolValue(systemSettings.EnableDeduplication)
+	model.DeleteDuplicates = boolPointerToBasetypesBoolValue(systemSettings.DeleteDuplicates)
+	model.MaxDupes = defectdojoNullableInt32ToBasetypesInt64Value(systemSettings.MaxDupes)
+	model.FalsePositiveHistory = boolPointerToBasetypesBoolValue(systemSettings.FalsePositiveHistory)
+	model.RetroactiveFalsePositiveHistory = boolPointerToBasetypesBoolValue(systemSettings.RetroactiveFalsePositiveHistory)
+	model.EmailFrom = stringPointerToBasetypesStringValue(systemSettings.EmailFrom)
+	model.URLPrefix = stringPointerToBasetypesStringValue(systemSettings.UrlPrefix)
+	model.TeamName = stringPointerToBasetypesStringValue(systemSettings.TeamName)
+	model.EnableJira = boolPointerToBasetypesBoolValue(systemSettings.EnableJira)
+	model.EnableJiraWebHook = boolPointerToBasetypesBoolValue(systemSettings.EnableJiraWebHook)
+	model.DisableJiraWebhookSecret = boolPointerToBasetypesBoolValue(systemSettings.DisableJiraWebhookSecret)
+	model.JiraMinimumSeverity = defectdojoNullableStringToBasetypesStringValue(systemSettings.JiraMinimumSeverity)
+	model.JiraLabels = defectdojoNullableStringToBasetypesStringValue(systemSettings.JiraLabels)
+	model.AddVulnerabilityIDToJiraLabel = boolPointerToBasetypesBoolValue(systemSettings.AddVulnerabilityIdToJiraLabel)
+	model.EnableGithub = boolPointerToBasetypesBoolValue(systemSettings.EnableGithub)
+	model.EnableSlackNotifications = boolPointerToBasetypesBoolValue(systemSettings.EnableSlackNotifications)
+	model.SlackChannel = stringPointerToBasetypesStringValue(systemSettings.SlackChannel)
+	model.SlackUsername = stringPointerToBasetypesStringValue(systemSettings.SlackUsername)
+	model.EnableMSTeamsNotifications = boolPointerToBasetypesBoolValue(systemSettings.EnableMsteamsNotifications)
+	model.MSTeamsURL = stringPointerToBasetypesStringValue(systemSettings.MsteamsUrl)
+	model.EnableMailNotifications = boolPointerToBasetypesBoolValue(systemSettings.EnableMailNotifications)
+	model.MailNotificationsTo = stringPointerToBasetypesStringValue(systemSettings.MailNotificationsTo)
+	model.EnableWebhooksNotifications = boolPointerToBasetypesBoolValue(systemSettings.EnableWebhooksNotifications)
+	model.EnableFindingSLA = boolPointerToBasetypesBoolValue(systemSettings.EnableFindingSla)
+	model.EnableNotifySLAActive = boolPointerToBasetypesBoolValue(systemSettings.EnableNotifySlaActive)
+	model.EnableNotifySLAActiveVerified = boolPointerToBasetypesBoolValue(systemSettings.EnableNotifySlaActiveVerified)
+	model.EnableNotifySLAJiraOnly = boolPointerToBasetypesBoolValue(systemSettings.EnableNotifySlaJiraOnly)
+	model.EnableNotifySLAExponentialBackoff = boolPointerToBasetypesBoolValue(systemSettings.EnableNotifySlaExponentialBackoff)
+	model.RiskAcceptanceFormDefaultDays = defectdojoNullableInt32ToBasetypesInt64Value(systemSettings.RiskAcceptanceFormDefaultDays)
+	model.RiskAcceptanceNotifyBeforeExpiration = defectdojoNullableInt32ToBasetypesInt64Value(systemSettings.RiskAcceptanceNotifyBeforeExpiration)
+	model.EnableProductGrade = boolPointerToBasetypesBoolValue(systemSettings.EnableProductGrade)
+	model.ProductGradeA = int32PointerToBasetypesInt64Value(systemSettings.ProductGradeA)
+	model.ProductGradeB = int32PointerToBasetypesInt64Value(systemSettings.ProductGradeB)
+	model.ProductGradeC = int32PointerToBasetypesInt64Value(systemSettings.ProductGradeC)
+	model.ProductGradeD = int32PointerToBasetypesInt64Value(systemSettings.ProductGradeD)
+	model.ProductGradeF = int32PointerToBasetypesInt64Value(systemSettings.ProductGradeF)
+	model.EnableProductTagInheritance = boolPointerToBasetypesBoolValue(systemSettings.EnableProductTagInheritance)
+	model.EnableBenchmark = boolPointerToBasetypesBoolValue(systemSettings.EnableBenchmark)
+	model.EnableTemplateMatch = boolPointerToBasetypesBoolValue(systemSettings.EnableTemplateMatch)
+	model.EnableSimilarFindings = boolPointerToBasetypesBoolValue(systemSettings.EnableSimilarFindings)
+	model.EnableCalendar = boolPointerToBasetypesBoolValue(systemSettings.EnableCalendar)
+	model.EnableQuestionnaires = boolPointerToBasetypesBoolValue(systemSettings.EnableQuestionnaires)
+	model.EnableChecklists = boolPointerToBasetypesBoolValue(systemSettings.EnableChecklists)
+	model.EnableCredentials = boolPointerToBasetypesBoolValue(systemSettings.EnableCredentials)
+	model.EnableEndpointMetadataImport = boolPointerToBasetypesBoolValue(systemSettings.EnableEndpointMetadataImport)
+	model.EnableUserProfileEditable = boolPointerToBasetypesBoolValue(systemSettings.EnableUserProfileEditable)
+	model.DefaultGroup = defectdojoNullableInt32ToBasetypesInt64Value(systemSettings.DefaultGroup)
+	model.DefaultGroupRole = defectdojoNullableInt32ToBasetypesInt64Value(systemSettings.DefaultGroupRole)
+	model.DefaultGroupEmailPattern = defectdojoNullableStringToBasetypesStringValue(systemSettings.DefaultGroupEmailPattern)
+	model.MinimumPasswordLength = int32PointerToBasetypesInt64Value(systemSettings.MinimumPasswordLength)
+	model.MaximumPasswordLength = int32PointerToBasetypesInt64Value(systemSettings.MaximumPasswordLength)
+	model.NumberCharacterRequired = boolPointerToBasetypesBoolValue(systemSettings.NumberCharacterRequired)
+	model.SpecialCharacterRequired = boolPointerToBasetypesBoolValue(systemSettings.SpecialCharacterRequired)
+	model.LowercaseCharacterRequired = boolPointerToBasetypesBoolValue(systemSettings.LowercaseCharacterRequired)
+	model.UppercaseCharacterRequired = boolPointerToBasetypesBoolValue(systemSettings.UppercaseCharacterRequired)
+	model.NonCommonPasswordRequired = boolPointerToBasetypesBoolValue(systemSettings.NonCommonPasswordRequired)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSystemSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_system_settings" "test" {
+					enable_deduplication   = true
+					delete_duplicates      = true
+					max_dupes              = 10
+					false_positive_history = true
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "enable_deduplication", "true"),
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "delete_duplicates", "true"),
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "max_dupes", "10"),
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "false_positive_history", "true"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_system_settings.test", "id"),
+					resource.TestCheckResourceAttrSet("defectdojo_system_settings.test", "enable_jira"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_system_settings.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_system_settings" "test" {
+					enable_deduplication  = true
+					delete_duplicates     = false
+					max_dupes             = 5
+					enable_product_grade  = true
+					jira_minimum_severity = "High"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "delete_duplicates", "false"),
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "max_dupes", "5"),
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "enable_product_grade", "true"),
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "jira_minimum_severity", "High"),
+
+					// Verify the previously configured value is kept
+					resource.TestCheckResourceAttr("defectdojo_system_settings.test", "false_positive_history", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
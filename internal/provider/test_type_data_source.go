@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TestTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &TestTypeDataSource{}
+)
+
+func NewTestTypeDataSource() datasource.DataSource {
+	return &TestTypeDataSource{}
+}
+
+// TestTypeDataSource defines the data source implementation.
+type TestTypeDataSource struct {
+	client *defectdojo.APIClient
+}
+
+// TestTypeDataSourceModel describes the data source data model.
+type TestTypeDataSourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	StaticTool  types.Bool   `tfsdk:"static_tool"`
+	DynamicTool types.Bool   `tfsdk:"dynamic_tool"`
+	Active      types.Bool   `tfsdk:"active"`
+	Tags        types.List   `tfsdk:"tags"`
+}
+
+// Metadata returns the data source type name.
+func (d *TestTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_type"
+}
+
+// Schema defines the schema for the data source.
+func (d *TestTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the test type",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the test type to look up",
+				Required:    true,
+			},
+			"static_tool": schema.BoolAttribute{
+				Description: "Whether the test type is a static analysis tool",
+				Computed:    true,
+			},
+			"dynamic_tool": schema.BoolAttribute{
+				Description: "Whether the test type is a dynamic analysis tool",
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the test type can be selected for new tests",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of tags for the test type",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TestTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *TestTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TestTypeDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testType, res, err := findInPages(func(offset int32) ([]defectdojo.TestType, bool, *http.Response, error) {
+		testTypes, res, err := d.client.TestTypesAPI.TestTypesList(ctx).Limit(pageSize).Offset(offset).Execute()
+		if err != nil {
+			return nil, false, res, err
+		}
+
+		return testTypes.Results, testTypes.Next.Get() != nil, res, nil
+	}, func(testType *defectdojo.TestType) bool {
+		return testType.GetName() == state.Name.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Test Types",
+			"Could not read test types, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	if testType == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Test Type Not Found",
+			"Could not find a test type with the name "+state.Name.String(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.Int64Value(int64(testType.GetId()))
+	state.StaticTool = boolPointerToBasetypesBoolValue(testType.StaticTool)
+	state.DynamicTool = boolPointerToBasetypesBoolValue(testType.DynamicTool)
+	state.Active = boolPointerToBasetypesBoolValue(testType.Active)
+	state.Tags, diags = types.ListValueFrom(ctx, types.StringType, testType.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
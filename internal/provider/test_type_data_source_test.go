@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTestTypeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_test_type" "test" {
+					name         = "Test Test Type Lookup"
+					dynamic_tool = true
+				}
+
+				data "defectdojo_test_type" "test" {
+					name = defectdojo_test_type.test.name
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify the test type was found by its name
+					resource.TestCheckResourceAttrPair("data.defectdojo_test_type.test", "id", "defectdojo_test_type.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_test_type.test", "dynamic_tool", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &testTypeResource{}
+	_ resource.ResourceWithConfigure   = &testTypeResource{}
+	_ resource.ResourceWithImportState = &testTypeResource{}
+)
+
+// NewTestTypeResource is a helper function to simplify the provider implementation.
+func NewTestTypeResource() resource.Resource {
+	return &testTypeResource{}
+}
+
+// testTypeResource is the resource implementation.
+type testTypeResource struct {
+	client *defectdojo.APIClient
+}
+
+type testTypeResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	StaticTool  types.Bool   `tfsdk:"static_tool"`
+	DynamicTool types.Bool   `tfsdk:"dynamic_tool"`
+	Active      types.Bool   `tfsdk:"active"`
+	Tags        types.List   `tfsdk:"tags"`
+}
+
+// Metadata returns the resource type name.
+func (r *testTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_type"
+}
+
+// Schema defines the schema for the resource.
+func (r *testTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a test type. Defectdojo doesn't allow deleting test types, so destroying this resource deactivates the test type",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the test type",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the test type, e.g. the scan type of a custom scanner",
+				Required:    true,
+			},
+			"static_tool": schema.BoolAttribute{
+				Description: "Whether the test type is a static analysis tool",
+				Computed:    true,
+				Optional:    true,
+			},
+			"dynamic_tool": schema.BoolAttribute{
+				Description: "Whether the test type is a dynamic analysis tool",
+				Computed:    true,
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the test type can be selected for new tests",
+				Computed:    true,
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "List of tags for the test type",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *testTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *testTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan testTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	testTypeRequest := testTypeRequestFromPlan(plan, tags)
+
+	// Create new test type
+	testType, res, err := r.client.TestTypesAPI.TestTypesCreate(ctx).TestTypeRequest(testTypeRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Test Type",
+			"Could not create test type, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(testType.GetId()))
+	mapTestTypeToModel(testType, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), testType.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *testTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state testTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed test type value from Defectdojo
+	testType, res, err := r.client.TestTypesAPI.TestTypesRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Test Type",
+			"Could not read test type with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(testType.GetId()))
+	mapTestTypeToModel(testType, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), testType.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *testTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan testTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make([]string, 0)
+	diags = plan.Tags.ElementsAs(ctx, &tags, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	testTypeRequest := testTypeRequestFromPlan(plan, tags)
+
+	// Update existing test type
+	_, res, err := r.client.TestTypesAPI.TestTypesUpdate(ctx, int32(plan.ID.ValueInt64())).TestTypeRequest(testTypeRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Test Type",
+			"Could not update test type with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed test type value from Defectdojo
+	testType, res, err := r.client.TestTypesAPI.TestTypesRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Test Type",
+			"Could not read test type with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(testType.GetId()))
+	mapTestTypeToModel(testType, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.SetAttribute(ctx, path.Root("tags"), testType.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deactivates the resource and removes the Terraform state on success.
+// Defectdojo doesn't allow deleting test types, so they are deactivated instead.
+func (r *testTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state testTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deactivate existing test type
+	active := false
+	_, res, err := r.client.TestTypesAPI.TestTypesPartialUpdate(ctx, int32(state.ID.ValueInt64())).PatchedTestTypeRequest(defectdojo.PatchedTestTypeRequest{Active: &active}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Test Type",
+			"Could not deactivate test type, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *testTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// testTypeRequestFromPlan generates the request for creating and updating a test type.
+func testTypeRequestFromPlan(plan testTypeResourceModel, tags []string) defectdojo.TestTypeRequest {
+	return defectdojo.TestTypeRequest{
+		Name:        plan.Name.ValueString(),
+		StaticTool:  basetypesBoolValueToBoolPointer(plan.StaticTool),
+		DynamicTool: basetypesBoolValueToBoolPointer(plan.DynamicTool),
+		Active:      basetypesBoolValueToBoolPointer(plan.Active),
+		Tags:        tags,
+	}
+}
+
+// mapTestTypeToModel maps a test type to the model, except for the tags.
+func mapTestTypeToModel(testType *defectdojo.TestType, model *testTypeResourceModel) {
+	model.Name = types.StringValue(testType.GetName())
+	model.StaticTool = boolPointerToBasetypesBoolValue(testType.StaticTool)
+	model.DynamicTool = boolPointerToBasetypesBoolValue(testType.DynamicTool)
+	model.Active = boolPointerToBasetypesBoolValue(testType.Active)
+}
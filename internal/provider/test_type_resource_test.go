@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTestTypeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_test_type" "test" {
+					name        = "Test Test Type"
+					static_tool = true
+					tags        = ["custom"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "name", "Test Test Type"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "static_tool", "true"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "tags.0", "custom"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_test_type.test", "id"),
+					resource.TestCheckResourceAttrSet("defectdojo_test_type.test", "active"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_test_type.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_test_type" "test" {
+					name         = "Test Test Type"
+					static_tool  = false
+					dynamic_tool = true
+					active       = true
+					tags         = ["custom", "dast"]
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "static_tool", "false"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "dynamic_tool", "true"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "tags.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// tokenCache stores tokens fetched with a username and password on disk,
+// so other provider instances can reuse them instead of logging in again.
+type tokenCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// tokenCacheEntry is the content of a cached token file.
+type tokenCacheEntry struct {
+	Token   string    `json:"token"`
+	Expires time.Time `json:"expires"`
+}
+
+// path returns the file of the token for the given host and username.
+// the key is hashed to avoid issues with characters that are not allowed in file names.
+func (c *tokenCache) path(host string, username string) string {
+	key := sha256.Sum256([]byte(host + "\n" + username))
+
+	return filepath.Join(c.dir, hex.EncodeToString(key[:])+".json")
+}
+
+// load returns the cached token for the given host and username.
+// an empty string is returned if there is no token or it has expired.
+func (c *tokenCache) load(host string, username string) string {
+	content, err := os.ReadFile(c.path(host, username))
+	if err != nil {
+		return ""
+	}
+
+	var entry tokenCacheEntry
+	if err := json.Unmarshal(content, &entry); err != nil {
+		return ""
+	}
+
+	if time.Now().After(entry.Expires) {
+		return ""
+	}
+
+	return entry.Token
+}
+
+// store caches the token for the given host and username.
+func (c *tokenCache) store(host string, username string, token string) error {
+	content, err := json.Marshal(tokenCacheEntry{
+		Token:   token,
+		Expires: time.Now().Add(c.ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so parallel runs never read a partially written token
+	f, err := os.CreateTemp(c.dir, ".token-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), c.path(host, username))
+}
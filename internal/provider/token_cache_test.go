@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitTokenCache(t *testing.T) {
+	cache := &tokenCache{dir: t.TempDir(), ttl: time.Hour}
+
+	require.Equal(t, "", cache.load("https://defectdojo.example.com", "admin"))
+
+	require.NoError(t, cache.store("https://defectdojo.example.com", "admin", "secret"))
+
+	require.Equal(t, "secret", cache.load("https://defectdojo.example.com", "admin"))
+	require.Equal(t, "", cache.load("https://defectdojo.example.com", "user"))
+	require.Equal(t, "", cache.load("https://other.example.com", "admin"))
+}
+
+func TestUnitTokenCacheExpired(t *testing.T) {
+	cache := &tokenCache{dir: t.TempDir(), ttl: -time.Hour}
+
+	require.NoError(t, cache.store("https://defectdojo.example.com", "admin", "secret"))
+
+	require.Equal(t, "", cache.load("https://defectdojo.example.com", "admin"))
+}
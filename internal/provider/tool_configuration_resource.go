@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &toolConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &toolConfigurationResource{}
+	_ resource.ResourceWithImportState    = &toolConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &toolConfigurationResource{}
+)
+
+// toolConfigurationCredentials are the credentials required by each authentication type.
+var toolConfigurationCredentials = map[string][]string{
+	"API":      {"api_key"},
+	"Password": {"username", "password"},
+	"SSH":      {"ssh"},
+}
+
+// NewToolConfigurationResource is a helper function to simplify the provider implementation.
+func NewToolConfigurationResource() resource.Resource {
+	return &toolConfigurationResource{}
+}
+
+// toolConfigurationResource is the resource implementation.
+type toolConfigurationResource struct {
+	client *defectdojo.APIClient
+}
+
+type toolConfigurationResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	URL                types.String `tfsdk:"url"`
+	ToolType           types.Int64  `tfsdk:"tool_type"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+	Extras             types.String `tfsdk:"extras"`
+	Username           types.String `tfsdk:"username"`
+	AuthTitle          types.String `tfsdk:"auth_title"`
+	Password           types.String `tfsdk:"password"`
+	SSH                types.String `tfsdk:"ssh"`
+	APIKey             types.String `tfsdk:"api_key"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+}
+
+// Metadata returns the resource type name.
+func (r *toolConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_configuration"
+}
+
+// Schema defines the schema for the resource.
+func (r *toolConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the tool configuration",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the tool configuration",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the tool configuration",
+				Computed:    true,
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the tool, e.g. https://jenkins.example.com",
+				Computed:    true,
+				Optional:    true,
+			},
+			"tool_type": schema.Int64Attribute{
+				Description: "The ID of the tool type",
+				Required:    true,
+			},
+			"authentication_type": schema.StringAttribute{
+				Description: "The authentication type, one of API, Password or SSH. API requires api_key, Password requires username and password and SSH requires ssh",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("API", "Password", "SSH"),
+				},
+			},
+			"extras": schema.StringAttribute{
+				Description: "Additional definitions that will be consumed by scanner",
+				Computed:    true,
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username for the Password authentication type",
+				Computed:    true,
+				Optional:    true,
+			},
+			"auth_title": schema.StringAttribute{
+				Description: "The title of the API key for the API authentication type",
+				Computed:    true,
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password for the Password authentication type. The value is write-only and never stored in the state, which requires Terraform 1.11 or later",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"ssh": schema.StringAttribute{
+				Description: "The private SSH key for the SSH authentication type. The value is write-only and never stored in the state, which requires Terraform 1.11 or later",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API key for the API authentication type. The value is write-only and never stored in the state, which requires Terraform 1.11 or later",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_version": schema.Int64Attribute{
+				Description: "Changing this value updates the tool configuration with the current values of password, ssh and api_key. Defectdojo does not return the credentials, so this is the only way to detect a rotation",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the credentials required by the authentication type are set.
+func (r *toolConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var authenticationType types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("authentication_type"), &authenticationType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if authenticationType.IsNull() || authenticationType.IsUnknown() {
+		return
+	}
+
+	for _, attribute := range toolConfigurationCredentials[authenticationType.ValueString()] {
+		var value types.String
+		diags = req.Config.GetAttribute(ctx, path.Root(attribute), &value)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Tool Configuration Credentials",
+				fmt.Sprintf("The authentication type %s requires %s to be set.", authenticationType.ValueString(), attribute),
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *toolConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *toolConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan toolConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only values are only available in the config
+	var config toolConfigurationResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	toolConfigurationRequest := toolConfigurationRequestFromPlan(plan, config)
+
+	// Create new tool configuration
+	toolConfiguration, res, err := r.client.ToolConfigurationsAPI.ToolConfigurationsCreate(ctx).ToolConfigurationRequest(toolConfigurationRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Tool Configuration",
+			"Could not create tool configuration, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(toolConfiguration.GetId()))
+	mapToolConfigurationToModel(toolConfiguration, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *toolConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state toolConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed tool configuration value from Defectdojo
+	toolConfiguration, res, err := r.client.ToolConfigurationsAPI.ToolConfigurationsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Tool Configuration",
+			"Could not read tool configuration with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(toolConfiguration.GetId()))
+	mapToolConfigurationToModel(toolConfiguration, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *toolConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan toolConfigurationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the credentials are sent on every update, otherwise Defectdojo would remove them
+	var config toolConfigurationResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	toolConfigurationRequest := toolConfigurationRequestFromPlan(plan, config)
+
+	// Update existing tool configuration
+	_, res, err := r.client.ToolConfigurationsAPI.ToolConfigurationsUpdate(ctx, int32(plan.ID.ValueInt64())).ToolConfigurationRequest(toolConfigurationRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Tool Configuration",
+			"Could not update tool configuration with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed tool configuration value from Defectdojo
+	toolConfiguration, res, err := r.client.ToolConfigurationsAPI.ToolConfigurationsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Tool Configuration",
+			"Could not read tool configuration with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(toolConfiguration.GetId()))
+	mapToolConfigurationToModel(toolConfiguration, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *toolConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state toolConfigurationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing tool configuration
+	res, err := r.client.ToolConfigurationsAPI.ToolConfigurationsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Tool Configuration",
+			"Could not delete tool configuration, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *toolConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// toolConfigurationRequestFromPlan generates the request for creating and updating a tool configuration.
+// the write-only credentials are taken from the config as they are always null in the plan.
+func toolConfigurationRequestFromPlan(plan toolConfigurationResourceModel, config toolConfigurationResourceModel) defectdojo.ToolConfigurationRequest {
+	return defectdojo.ToolConfigurationRequest{
+		Name:               plan.Name.ValueString(),
+		Description:        basetypesStringValueToDefectdojoNullableString(plan.Description),
+		ConfigurationUrl:   basetypesStringValueToDefectdojoNullableString(plan.URL),
+		ToolType:           int32(plan.ToolType.ValueInt64()),
+		AuthenticationType: basetypesStringValueToDefectdojoNullableString(plan.AuthenticationType),
+		Extras:             basetypesStringValueToDefectdojoNullableString(plan.Extras),
+		Username:           basetypesStringValueToDefectdojoNullableString(plan.Username),
+		AuthTitle:          basetypesStringValueToDefectdojoNullableString(plan.AuthTitle),
+		Password:           basetypesStringValueToDefectdojoNullableString(config.Password),
+		Ssh:                basetypesStringValueToDefectdojoNullableString(config.SSH),
+		ApiKey:             basetypesStringValueToDefectdojoNullableString(config.APIKey),
+	}
+}
+
+// mapToolConfigurationToModel maps a tool configuration to the model.
+// the credentials are left untouched as Defectdojo never returns them.
+func mapToolConfigurationToModel(toolConfiguration *defectdojo.ToolConfiguration, model *toolConfigurationResourceModel) {
+	model.Name = types.StringValue(toolConfiguration.GetName())
+	model.Description = defectdojoNullableStringToBasetypesStringValue(toolConfiguration.Description)
+	model.URL = defectdojoNullableStringToBasetypesStringValue(toolConfiguration.ConfigurationUrl)
+	model.ToolType = types.Int64Value(int64(toolConfiguration.GetToolType()))
+	model.AuthenticationType = defectdojoNullableStringToBasetypesStringValue(toolConfiguration.AuthenticationType)
+	model.Extras = defectdojoNullableStringToBasetypesStringValue(toolConfiguration.Extras)
+	model.Username = defectdojoNullableStringToBasetypesStringValue(toolConfiguration.Username)
+	model.AuthTitle = defectdojoNullableStringToBasetypesStringValue(toolConfiguration.AuthTitle)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccToolConfigurationDependencies = `
+resource "defectdojo_tool_type" "test_tool_type" {
+	name = "Test Tool Type"
+}
+`
+
+func TestAccToolConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks:   testAccWriteOnlyVersionChecks,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + testAccToolConfigurationDependencies + `
+				resource "defectdojo_tool_configuration" "test" {
+					name                = "Test Tool Configuration"
+					tool_type           = defectdojo_tool_type.test_tool_type.id
+					authentication_type = "Password"
+					username            = "defectdojo"
+				}
+			`,
+				ExpectError: regexp.MustCompile("Missing Tool Configuration Credentials"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccToolConfigurationDependencies + `
+				resource "defectdojo_tool_configuration" "test" {
+					name                = "Test Tool Configuration"
+					description         = "This is the description of the Test Tool Configuration"
+					url                 = "https://jenkins.example.com"
+					tool_type           = defectdojo_tool_type.test_tool_type.id
+					authentication_type = "API"
+					auth_title          = "defectdojo"
+					api_key             = "api-key"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "name", "Test Tool Configuration"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "description", "This is the description of the Test Tool Configuration"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "url", "https://jenkins.example.com"),
+					resource.TestCheckResourceAttrPair("defectdojo_tool_configuration.test", "tool_type", "defectdojo_tool_type.test_tool_type", "id"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "authentication_type", "API"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "auth_title", "defectdojo"),
+
+					// Verify write-only values are not stored in the state
+					resource.TestCheckNoResourceAttr("defectdojo_tool_configuration.test", "api_key"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_tool_configuration.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_tool_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccToolConfigurationDependencies + `
+				resource "defectdojo_tool_configuration" "test" {
+					name                = "Test Tool Configuration"
+					description         = "This is the description of the Test Tool Configuration"
+					url                 = "https://jenkins.example.com"
+					tool_type           = defectdojo_tool_type.test_tool_type.id
+					authentication_type = "Password"
+					username            = "defectdojo"
+					password            = "password"
+					credentials_version = 2
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "authentication_type", "Password"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "username", "defectdojo"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "credentials_version", "2"),
+					resource.TestCheckNoResourceAttr("defectdojo_tool_configuration.test", "password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prempador/go-defectdojo"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &toolProductSettingResource{}
+	_ resource.ResourceWithConfigure   = &toolProductSettingResource{}
+	_ resource.ResourceWithImportState = &toolProductSettingResource{}
+)
+
+// NewToolProductSettingResource is a helper function to simplify the provider implementation.
+func NewToolProductSettingResource() resource.Resource {
+	return &toolProductSettingResource{}
+}
+
+// toolProductSettingResource is the resource implementation.
+type toolProductSettingResource struct {
+	client *defectdojo.APIClient
+}
+
+type toolProductSettingResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	URL               types.String `tfsdk:"url"`
+	Product           types.Int64  `tfsdk:"product"`
+	ToolConfiguration types.Int64  `tfsdk:"tool_configuration"`
+	ToolProjectID     types.String `tfsdk:"tool_project_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *toolProductSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_product_setting"
+}
+
+// Schema defines the schema for the resource.
+func (r *toolProductSettingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The unique identifier for the tool product setting",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the tool product setting",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the tool product setting",
+				Computed:    true,
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the product in the tool, e.g. the Jenkins job or the GitLab project",
+				Required:    true,
+			},
+			"product": schema.Int64Attribute{
+				Description: "The ID of the product",
+				Required:    true,
+			},
+			"tool_configuration": schema.Int64Attribute{
+				Description: "The ID of the tool configuration",
+				Required:    true,
+			},
+			"tool_project_id": schema.StringAttribute{
+				Description: "The ID of the project in the tool",
+				Computed:    true,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *toolProductSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *toolProductSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan toolProductSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	toolProductSettingRequest := toolProductSettingRequestFromPlan(plan)
+
+	// Create new tool product setting
+	toolProductSetting, res, err := r.client.ToolProductSettingsAPI.ToolProductSettingsCreate(ctx).ToolProductSettingsRequest(toolProductSettingRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Defectdojo Tool Product Setting",
+			"Could not create tool product setting, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(toolProductSetting.GetId()))
+	mapToolProductSettingToModel(toolProductSetting, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *toolProductSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state toolProductSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed tool product setting value from Defectdojo
+	toolProductSetting, res, err := r.client.ToolProductSettingsAPI.ToolProductSettingsRetrieve(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Tool Product Setting",
+			"Could not read tool product setting with ID "+state.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Overwrite state with refreshed state
+	state.ID = types.Int64Value(int64(toolProductSetting.GetId()))
+	mapToolProductSettingToModel(toolProductSetting, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *toolProductSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan toolProductSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate request from plan
+	toolProductSettingRequest := toolProductSettingRequestFromPlan(plan)
+
+	// Update existing tool product setting
+	_, res, err := r.client.ToolProductSettingsAPI.ToolProductSettingsUpdate(ctx, int32(plan.ID.ValueInt64())).ToolProductSettingsRequest(toolProductSettingRequest).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Defectdojo Tool Product Setting",
+			"Could not update tool product setting with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Get refreshed tool product setting value from Defectdojo
+	toolProductSetting, res, err := r.client.ToolProductSettingsAPI.ToolProductSettingsRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Defectdojo Tool Product Setting",
+			"Could not read tool product setting with ID "+plan.ID.String()+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(toolProductSetting.GetId()))
+	mapToolProductSettingToModel(toolProductSetting, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *toolProductSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state toolProductSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing tool product setting
+	res, err := r.client.ToolProductSettingsAPI.ToolProductSettingsDestroy(ctx, int32(state.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Defectdojo Tool Product Setting",
+			"Could not delete tool product setting, unexpected error: "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return
+	}
+}
+
+func (r *toolProductSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID",
+			"Could not convert ID to integer: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+}
+
+// toolProductSettingRequestFromPlan generates the request for creating and updating a tool product setting.
+func toolProductSettingRequestFromPlan(plan toolProductSettingResourceModel) defectdojo.ToolProductSettingsRequest {
+	return defectdojo.ToolProductSettingsRequest{
+		Name:              plan.Name.ValueString(),
+		Description:       basetypesStringValueToDefectdojoNullableString(plan.Description),
+		Url:               plan.URL.ValueString(),
+		Product:           int32(plan.Product.ValueInt64()),
+		ToolConfiguration: int32(plan.ToolConfiguration.ValueInt64()),
+		ToolProjectId:     basetypesStringValueToDefectdojoNullableString(plan.ToolProjectID),
+	}
+}
+
+// mapToolProductSettingToModel maps a tool product setting to the model.
+func mapToolProductSettingToModel(toolProductSetting *defectdojo.ToolProductSettings, model *toolProductSettingResourceModel) {
+	model.Name = types.StringValue(toolProductSetting.GetName())
+	model.Description = defectdojoNullableStringToBasetypesStringValue(toolProductSetting.Description)
+	model.URL = types.StringValue(toolProductSetting.GetUrl())
+	model.Product = types.Int64Value(int64(toolProductSetting.GetProduct()))
+	model.ToolConfiguration = types.Int64Value(int64(toolProductSetting.GetToolConfiguration()))
+	model.ToolProjectID = defectdojoNullableStringToBasetypesStringValue(toolProductSetting.ToolProjectId)
+}
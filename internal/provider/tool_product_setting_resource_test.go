@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccToolProductSettingDependencies = `
+resource "defectdojo_product_type" "test_product_type" {
+	name        = "Test Product Type"
+	description = "This is the description of the Test Product Type"
+}
+
+resource "defectdojo_product" "test_product" {
+	name        = "Test Product"
+	description = "This is the description of the Test Product"
+	prod_type   = defectdojo_product_type.test_product_type.id
+}
+
+resource "defectdojo_tool_type" "test_tool_type" {
+	name = "Test Tool Type"
+}
+
+resource "defectdojo_tool_configuration" "test_tool_configuration" {
+	name      = "Test Tool Configuration"
+	url       = "https://gitlab.example.com"
+	tool_type = defectdojo_tool_type.test_tool_type.id
+}
+`
+
+func TestAccToolProductSettingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccToolProductSettingDependencies + `
+				resource "defectdojo_tool_product_setting" "test" {
+					name               = "Test Tool Product Setting"
+					url                = "https://gitlab.example.com/security/test-product"
+					product            = defectdojo_product.test_product.id
+					tool_configuration = defectdojo_tool_configuration.test_tool_configuration.id
+					tool_project_id    = "42"
+				}
+
+				resource "defectdojo_engagement" "test_engagement" {
+					name                          = "Test Engagement"
+					product                       = defectdojo_product.test_product.id
+					target_start                  = "2024-01-01"
+					target_end                    = "2024-01-31"
+					engagement_type               = "CI/CD"
+					source_code_management_server = defectdojo_tool_configuration.test_tool_configuration.id
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_tool_product_setting.test", "name", "Test Tool Product Setting"),
+					resource.TestCheckResourceAttr("defectdojo_tool_product_setting.test", "url", "https://gitlab.example.com/security/test-product"),
+					resource.TestCheckResourceAttrPair("defectdojo_tool_product_setting.test", "product", "defectdojo_product.test_product", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_tool_product_setting.test", "tool_configuration", "defectdojo_tool_configuration.test_tool_configuration", "id"),
+					resource.TestCheckResourceAttr("defectdojo_tool_product_setting.test", "tool_project_id", "42"),
+					resource.TestCheckResourceAttrPair("defectdojo_engagement.test_engagement", "source_code_management_server", "defectdojo_tool_configuration.test_tool_configuration", "id"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_tool_product_setting.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_tool_product_setting.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccToolProductSettingDependencies + `
+				resource "defectdojo_tool_product_setting" "test" {
+					name               = "Test Tool Product Setting"
+					description        = "This is the description of the Test Tool Product Setting"
+					url                = "https://gitlab.example.com/security/test-product"
+					product            = defectdojo_product.test_product.id
+					tool_configuration = defectdojo_tool_configuration.test_tool_configuration.id
+					tool_project_id    = "43"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_tool_product_setting.test", "description", "This is the description of the Test Tool Product Setting"),
+					resource.TestCheckResourceAttr("defectdojo_tool_product_setting.test", "tool_project_id", "43"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
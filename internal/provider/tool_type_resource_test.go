@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccToolTypeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_tool_type" "test" {
+					name        = "Test Tool Type"
+					description = "This is the description of the Test Tool Type"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_tool_type.test", "name", "Test Tool Type"),
+					resource.TestCheckResourceAttr("defectdojo_tool_type.test", "description", "This is the description of the Test Tool Type"),
+
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("defectdojo_tool_type.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_tool_type.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "defectdojo_tool_type" "test" {
+					name        = "Test Tool Type Updated"
+					description = "This is the updated description of the Test Tool Type"
+				}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckResourceAttr("defectdojo_tool_type.test", "name", "Test Tool Type Updated"),
+					resource.TestCheckResourceAttr("defectdojo_tool_type.test", "description", "This is the updated description of the Test Tool Type"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
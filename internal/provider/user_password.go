@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// changeUserPassword sets the password of a user.
+// the Defectdojo API refuses password updates, so we have to go through the
+// password form of the Django admin.
+func changeUserPassword(ctx context.Context, providerData *DefectdojoProviderData, userID int32, password string) error {
+	admin, err := newDjangoAdminClient(ctx, providerData)
+	if err != nil {
+		return err
+	}
+
+	formPath := fmt.Sprintf("/admin/auth/user/%d/password/", userID)
+	page, err := admin.page(ctx, formPath)
+	if err != nil {
+		return fmt.Errorf("could not open the password form of the user: %w", err)
+	}
+
+	form := url.Values{
+		"password1": {password},
+		"password2": {password},
+	}
+
+	// since Django 5.1 the form can also disable password based authentication for the user,
+	// older releases of Defectdojo don't know the field
+	if strings.Contains(page, `name="usable_password"`) {
+		form.Set("usable_password", "true")
+	}
+
+	err = admin.submit(ctx, formPath, form)
+	if err != nil {
+		return fmt.Errorf("the password was not accepted, make sure it satisfies the password policy of Defectdojo: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPasswordForm serves the password form of the user with the ID 2.
+// usablePassword adds the field Django 5.1 introduced to the form.
+func newTestPasswordForm(t *testing.T, passwords map[string]string, usablePassword bool) *httptest.Server {
+	server, mux := newTestDjangoAdmin(t, passwords)
+
+	mux.HandleFunc("/admin/auth/user/2/password/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			form := `<input type="password" name="password1"><input type="password" name="password2">`
+			if usablePassword {
+				form += `<input type="radio" name="usable_password" value="true">`
+			}
+
+			_, err := w.Write([]byte(form))
+			require.NoError(t, err)
+			return
+		}
+
+		requireTestDjangoAdminSession(t, r)
+
+		// the field may only be sent when the form has it
+		_, ok := r.PostForm["usable_password"]
+		require.Equal(t, usablePassword, ok)
+
+		if r.PostForm.Get("password1") != r.PostForm.Get("password2") || len(r.PostForm.Get("password1")) < 8 {
+			return
+		}
+
+		passwords["user"] = r.PostForm.Get("password1")
+		http.Redirect(w, r, "/admin/auth/user/2/change/", http.StatusFound)
+	})
+
+	return server
+}
+
+func TestUnitChangeUserPassword(t *testing.T) {
+	for _, usablePassword := range []bool{true, false} {
+		passwords := map[string]string{"admin": "adminPassword", "user": "oldPassword"}
+		server := newTestPasswordForm(t, passwords, usablePassword)
+		defer server.Close()
+
+		providerData := &DefectdojoProviderData{
+			HTTPClient: server.Client(),
+			Host:       server.URL,
+			Username:   "admin",
+			Password:   "adminPassword",
+		}
+
+		err := changeUserPassword(context.Background(), providerData, 2, "newPassword")
+		require.NoError(t, err)
+		require.Equal(t, "newPassword", passwords["user"])
+
+		err = changeUserPassword(context.Background(), providerData, 2, "short")
+		require.Error(t, err)
+		require.Equal(t, "newPassword", passwords["user"])
+	}
+}
+
+func TestUnitChangeUserPasswordUnknownUser(t *testing.T) {
+	server := newTestPasswordForm(t, map[string]string{"admin": "adminPassword"}, true)
+	defer server.Close()
+
+	providerData := &DefectdojoProviderData{
+		HTTPClient: server.Client(),
+		Host:       server.URL,
+		Username:   "admin",
+		Password:   "adminPassword",
+	}
+
+	err := changeUserPassword(context.Background(), providerData, 3, "newPassword")
+	require.ErrorContains(t, err, "could not open the password form")
+}
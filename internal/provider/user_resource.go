@@ -6,14 +6,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/prempador/go-defectdojo"
 )
 
@@ -31,7 +40,8 @@ func NewUserResource() resource.Resource {
 
 // userResource is the data source implementation.
 type userResource struct {
-	client *defectdojo.APIClient
+	client       *defectdojo.APIClient
+	providerData *DefectdojoProviderData
 }
 
 type userResourceModel struct {
@@ -43,7 +53,35 @@ type userResourceModel struct {
 	IsActive                 types.Bool   `tfsdk:"is_active"`
 	IsSuperUser              types.Bool   `tfsdk:"is_superuser"`
 	Password                 types.String `tfsdk:"password"`
+	PasswordVersion          types.Int64  `tfsdk:"password_version"`
 	ConfigurationPermissions types.List   `tfsdk:"configuration_permissions"`
+	ContactInfo              types.Object `tfsdk:"contact_info"`
+}
+
+// userContactInfoModel describes the contact info of a user.
+type userContactInfoModel struct {
+	Title              types.String `tfsdk:"title"`
+	PhoneNumber        types.String `tfsdk:"phone_number"`
+	CellNumber         types.String `tfsdk:"cell_number"`
+	TwitterUsername    types.String `tfsdk:"twitter_username"`
+	GithubUsername     types.String `tfsdk:"github_username"`
+	SlackUsername      types.String `tfsdk:"slack_username"`
+	SlackUserID        types.String `tfsdk:"slack_user_id"`
+	BlockExecution     types.Bool   `tfsdk:"block_execution"`
+	ForcePasswordReset types.Bool   `tfsdk:"force_password_reset"`
+}
+
+// userContactInfoAttrTypes are the attribute types of the contact_info object.
+var userContactInfoAttrTypes = map[string]attr.Type{
+	"title":                types.StringType,
+	"phone_number":         types.StringType,
+	"cell_number":          types.StringType,
+	"twitter_username":     types.StringType,
+	"github_username":      types.StringType,
+	"slack_username":       types.StringType,
+	"slack_user_id":        types.StringType,
+	"block_execution":      types.BoolType,
+	"force_password_reset": types.BoolType,
 }
 
 // Metadata returns the data source type name.
@@ -91,9 +129,17 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "The password of the user",
+				Description: "The password of the user. The password is write-only and never stored in the state, which requires Terraform 1.11 or later",
 				Optional:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_version": schema.Int64Attribute{
+				Description: "Changing this value sets the password of the user to the current value of password. Requires the provider to be configured with the username and password of a superuser and the Django admin of Defectdojo to be enabled",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
 			},
 			"configuration_permissions": schema.ListAttribute{
 				ElementType: types.Int64Type,
@@ -101,6 +147,58 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Optional:    true,
 			},
+			"contact_info": schema.SingleNestedAttribute{
+				Description: "The contact info of the user. If not set, the contact info is not managed. Removing the attribute stops managing the contact info and leaves it in place. Importing a user reads its contact info",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Description: "The job title of the user",
+						Optional:    true,
+					},
+					"phone_number": schema.StringAttribute{
+						Description: "The phone number of the user in the format +999999999 with up to 15 digits",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(userPhoneNumberRegex, "must be in the format +999999999 with up to 15 digits"),
+						},
+					},
+					"cell_number": schema.StringAttribute{
+						Description: "The cell phone number of the user in the format +999999999 with up to 15 digits",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(userPhoneNumberRegex, "must be in the format +999999999 with up to 15 digits"),
+						},
+					},
+					"twitter_username": schema.StringAttribute{
+						Description: "The Twitter username of the user",
+						Optional:    true,
+					},
+					"github_username": schema.StringAttribute{
+						Description: "The GitHub username of the user",
+						Optional:    true,
+					},
+					"slack_username": schema.StringAttribute{
+						Description: "The Slack username of the user. Required for Slack notifications if slack_user_id is not set",
+						Optional:    true,
+					},
+					"slack_user_id": schema.StringAttribute{
+						Description: "The Slack user ID of the user, used to send Slack notifications",
+						Optional:    true,
+					},
+					"block_execution": schema.BoolAttribute{
+						Description: "Whether imports of the user are processed synchronously instead of in the background",
+						Computed:    true,
+						Optional:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"force_password_reset": schema.BoolAttribute{
+						Description: "Whether the user has to change the password on the next login",
+						Computed:    true,
+						Optional:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
 		},
 	}
 }
@@ -111,17 +209,18 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*defectdojo.APIClient)
+	providerData, ok := req.ProviderData.(*DefectdojoProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *defectdojo.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DefectdojoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -134,6 +233,14 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// write-only values are only available in the config
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	configurations := make([]*int32, 0)
 	diags = plan.ConfigurationPermissions.ElementsAs(ctx, &configurations, true)
 	resp.Diagnostics.Append(diags...)
@@ -145,11 +252,11 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	userRequest := defectdojo.UserRequest{
 		Username:                 plan.Username.ValueString(),
 		Email:                    plan.Email.ValueString(),
-		FirstName:                plan.FirstName.ValueStringPointer(),
-		LastName:                 plan.LastName.ValueStringPointer(),
-		IsActive:                 plan.IsActive.ValueBoolPointer(),
-		IsSuperuser:              plan.IsSuperUser.ValueBoolPointer(),
-		Password:                 plan.Password.ValueStringPointer(),
+		FirstName:                basetypesStringValueToStringPointer(plan.FirstName),
+		LastName:                 basetypesStringValueToStringPointer(plan.LastName),
+		IsActive:                 basetypesBoolValueToBoolPointer(plan.IsActive),
+		IsSuperuser:              basetypesBoolValueToBoolPointer(plan.IsSuperUser),
+		Password:                 basetypesStringValueToStringPointer(password),
 		ConfigurationPermissions: configurations,
 	}
 
@@ -166,11 +273,20 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(user.GetId()))
 	plan.Username = types.StringValue(user.GetUsername())
-	plan.FirstName = types.StringValue(user.GetFirstName())
-	plan.LastName = types.StringValue(user.GetLastName())
+	plan.FirstName = stringPointerToBasetypesStringValue(user.FirstName)
+	plan.LastName = stringPointerToBasetypesStringValue(user.LastName)
 	plan.Email = types.StringValue(user.GetEmail())
-	plan.IsActive = types.BoolValue(user.GetIsActive())
-	plan.IsSuperUser = types.BoolValue(user.GetIsSuperuser())
+	plan.IsActive = boolPointerToBasetypesBoolValue(user.IsActive)
+	plan.IsSuperUser = boolPointerToBasetypesBoolValue(user.IsSuperuser)
+
+	// Manage the contact info of the user if configured
+	if !plan.ContactInfo.IsNull() {
+		plan.ContactInfo, diags = r.applyUserContactInfo(ctx, user.GetId(), plan.ContactInfo)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -209,11 +325,19 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Overwrite state with refreshed state
 	state.ID = types.Int64Value(int64(user.GetId()))
 	state.Username = types.StringValue(user.GetUsername())
-	state.FirstName = types.StringValue(user.GetFirstName())
-	state.LastName = types.StringValue(user.GetLastName())
+	state.FirstName = stringPointerToBasetypesStringValue(user.FirstName)
+	state.LastName = stringPointerToBasetypesStringValue(user.LastName)
 	state.Email = types.StringValue(user.GetEmail())
-	state.IsActive = types.BoolValue(user.GetIsActive())
-	state.IsSuperUser = types.BoolValue(user.GetIsSuperuser())
+	state.IsActive = boolPointerToBasetypesBoolValue(user.IsActive)
+	state.IsSuperUser = boolPointerToBasetypesBoolValue(user.IsSuperuser)
+
+	if !state.ContactInfo.IsNull() {
+		state.ContactInfo, diags = r.readUserContactInfo(ctx, user.GetId())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -239,14 +363,6 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	if !plan.Password.IsNull() {
-		resp.Diagnostics.AddError(
-			"Password Update Not Supported",
-			"Password updates are not supported by the Defectdojo API. Please remove the password attribute from the update plan.",
-		)
-		return
-	}
-
 	configurations := make([]*int32, 0)
 	diags = plan.ConfigurationPermissions.ElementsAs(ctx, &configurations, true)
 	resp.Diagnostics.Append(diags...)
@@ -258,10 +374,10 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	userRequest := defectdojo.UserRequest{
 		Username:    plan.Username.ValueString(),
 		Email:       plan.Email.ValueString(),
-		FirstName:   plan.FirstName.ValueStringPointer(),
-		LastName:    plan.LastName.ValueStringPointer(),
-		IsActive:    plan.IsActive.ValueBoolPointer(),
-		IsSuperuser: plan.IsSuperUser.ValueBoolPointer(),
+		FirstName:   basetypesStringValueToStringPointer(plan.FirstName),
+		LastName:    basetypesStringValueToStringPointer(plan.LastName),
+		IsActive:    basetypesBoolValueToBoolPointer(plan.IsActive),
+		IsSuperuser: basetypesBoolValueToBoolPointer(plan.IsSuperUser),
 	}
 
 	// Update existing user
@@ -274,6 +390,33 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Rotate the password if the password version changed
+	var state userResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) && !plan.PasswordVersion.IsNull() {
+		// write-only values are only available in the config
+		var password types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = changeUserPassword(ctx, r.providerData, int32(plan.ID.ValueInt64()), password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Changing Defectdojo User Password",
+				"Could not change password of user with ID "+plan.ID.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Get refreshed user value from Defectdojo
 	user, res, err := r.client.UsersAPI.UsersRetrieve(ctx, int32(plan.ID.ValueInt64())).Execute()
 	if err != nil {
@@ -287,11 +430,29 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(user.GetId()))
 	plan.Username = types.StringValue(user.GetUsername())
-	plan.FirstName = types.StringValue(user.GetFirstName())
-	plan.LastName = types.StringValue(user.GetLastName())
+	plan.FirstName = stringPointerToBasetypesStringValue(user.FirstName)
+	plan.LastName = stringPointerToBasetypesStringValue(user.LastName)
 	plan.Email = types.StringValue(user.GetEmail())
-	plan.IsActive = types.BoolValue(user.GetIsActive())
-	plan.IsSuperUser = types.BoolValue(user.GetIsSuperuser())
+	plan.IsActive = boolPointerToBasetypesBoolValue(user.IsActive)
+	plan.IsSuperUser = boolPointerToBasetypesBoolValue(user.IsSuperuser)
+
+	// The contact info is left in place when the attribute is removed
+	if plan.ContactInfo.IsNull() && !state.ContactInfo.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("contact_info"),
+			"Defectdojo User Contact Info No Longer Managed",
+			"The contact_info attribute of user with ID "+plan.ID.String()+" was removed. The contact info is left in place and is no longer managed by Terraform.",
+		)
+	}
+
+	// Manage the contact info of the user if configured
+	if !plan.ContactInfo.IsNull() {
+		plan.ContactInfo, diags = r.applyUserContactInfo(ctx, user.GetId(), plan.ContactInfo)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -339,4 +500,120 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(id)))...)
+
+	// the contact info is only refreshed if it is in the state, so it is read once here
+	contactInfo, diags := r.readUserContactInfo(ctx, int32(id))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("contact_info"), contactInfo)...)
+}
+
+// userPhoneNumberRegex matches the phone numbers accepted by Defectdojo.
+var userPhoneNumberRegex = regexp.MustCompile(`^\+?1?\d{9,15}$`)
+
+// findUserContactInfo returns the contact info of a user or nil if the user has none.
+func (r *userResource) findUserContactInfo(ctx context.Context, userID int32) (*defectdojo.UserContactInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	contactInfoList, res, err := r.client.UserContactInfosAPI.UserContactInfosList(ctx).User(userID).Execute()
+	if err != nil {
+		diags.AddError(
+			"Error Reading Defectdojo User Contact Info",
+			"Could not read contact info of user with ID "+strconv.Itoa(int(userID))+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return nil, diags
+	}
+
+	for i := range contactInfoList.Results {
+		if contactInfoList.Results[i].GetUser() == userID {
+			return &contactInfoList.Results[i], diags
+		}
+	}
+
+	return nil, diags
+}
+
+// readUserContactInfo returns the contact info of a user as an object, which is null if the user has none.
+func (r *userResource) readUserContactInfo(ctx context.Context, userID int32) (types.Object, diag.Diagnostics) {
+	contactInfo, diags := r.findUserContactInfo(ctx, userID)
+	if diags.HasError() || contactInfo == nil {
+		return types.ObjectNull(userContactInfoAttrTypes), diags
+	}
+
+	return mapUserContactInfoToObject(ctx, contactInfo)
+}
+
+// applyUserContactInfo creates or updates the contact info of a user and returns the resulting object.
+func (r *userResource) applyUserContactInfo(ctx context.Context, userID int32, contactInfo types.Object) (types.Object, diag.Diagnostics) {
+	var model userContactInfoModel
+	diags := contactInfo.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return contactInfo, diags
+	}
+
+	// phone numbers aren't nullable, so unset numbers are sent as empty strings to clear them
+	phoneNumber := model.PhoneNumber.ValueString()
+	cellNumber := model.CellNumber.ValueString()
+
+	// Generate request from plan
+	contactInfoRequest := defectdojo.UserContactInfoRequest{
+		User:               userID,
+		Title:              basetypesStringValueToDefectdojoNullableString(model.Title),
+		PhoneNumber:        &phoneNumber,
+		CellNumber:         &cellNumber,
+		TwitterUsername:    basetypesStringValueToDefectdojoNullableString(model.TwitterUsername),
+		GithubUsername:     basetypesStringValueToDefectdojoNullableString(model.GithubUsername),
+		SlackUsername:      basetypesStringValueToDefectdojoNullableString(model.SlackUsername),
+		SlackUserId:        basetypesStringValueToDefectdojoNullableString(model.SlackUserID),
+		BlockExecution:     model.BlockExecution.ValueBoolPointer(),
+		ForcePasswordReset: model.ForcePasswordReset.ValueBoolPointer(),
+	}
+
+	// Defectdojo may already have created the contact info together with the user
+	existing, d := r.findUserContactInfo(ctx, userID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return contactInfo, diags
+	}
+
+	var updated *defectdojo.UserContactInfo
+	var res *http.Response
+	var err error
+	if existing != nil {
+		updated, res, err = r.client.UserContactInfosAPI.UserContactInfosUpdate(ctx, existing.GetId()).UserContactInfoRequest(contactInfoRequest).Execute()
+	} else {
+		updated, res, err = r.client.UserContactInfosAPI.UserContactInfosCreate(ctx).UserContactInfoRequest(contactInfoRequest).Execute()
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Updating Defectdojo User Contact Info",
+			"Could not update contact info of user with ID "+strconv.Itoa(int(userID))+": "+err.Error()+"\nDefectdojo responded with status: "+fmt.Sprintf("%v", res.Body),
+		)
+		return contactInfo, diags
+	}
+
+	result, d := mapUserContactInfoToObject(ctx, updated)
+	diags.Append(d...)
+	return result, diags
+}
+
+// mapUserContactInfoToObject maps the contact info of a user to the contact_info object.
+// empty strings are mapped to null as Defectdojo returns them for unset phone numbers.
+func mapUserContactInfoToObject(ctx context.Context, contactInfo *defectdojo.UserContactInfo) (types.Object, diag.Diagnostics) {
+	model := userContactInfoModel{
+		Title:              emptyStringToBasetypesStringNull(contactInfo.GetTitle()),
+		PhoneNumber:        emptyStringToBasetypesStringNull(contactInfo.GetPhoneNumber()),
+		CellNumber:         emptyStringToBasetypesStringNull(contactInfo.GetCellNumber()),
+		TwitterUsername:    emptyStringToBasetypesStringNull(contactInfo.GetTwitterUsername()),
+		GithubUsername:     emptyStringToBasetypesStringNull(contactInfo.GetGithubUsername()),
+		SlackUsername:      emptyStringToBasetypesStringNull(contactInfo.GetSlackUsername()),
+		SlackUserID:        emptyStringToBasetypesStringNull(contactInfo.GetSlackUserId()),
+		BlockExecution:     types.BoolValue(contactInfo.GetBlockExecution()),
+		ForcePasswordReset: types.BoolValue(contactInfo.GetForcePasswordReset()),
+	}
+
+	return types.ObjectValueFrom(ctx, userContactInfoAttrTypes, model)
 }
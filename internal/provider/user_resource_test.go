@@ -4,13 +4,17 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccUserResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks:   testAccWriteOnlyVersionChecks,
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -26,6 +30,7 @@ func TestAccUserResource(t *testing.T) {
 					// Verify set fields
 					resource.TestCheckResourceAttr("defectdojo_user.test", "username", "User"),
 					resource.TestCheckResourceAttr("defectdojo_user.test", "email", "email@email.com"),
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "password"),
 					// Verify default fields
 					resource.TestCheckResourceAttr("defectdojo_user.test", "first_name", ""),
 					resource.TestCheckResourceAttr("defectdojo_user.test", "last_name", ""),
@@ -51,6 +56,8 @@ func TestAccUserResource(t *testing.T) {
 				email 				= "email2@email.com"
 				is_active 			= true
 				is_superuser 		= true
+				password 			= "evenHarderPassword1234!"
+				password_version 	= 1
 			}
 			`,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -61,6 +68,9 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr("defectdojo_user.test", "email", "email2@email.com"),
 					resource.TestCheckResourceAttr("defectdojo_user.test", "is_active", "true"),
 					resource.TestCheckResourceAttr("defectdojo_user.test", "is_superuser", "true"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "password_version", "1"),
+					// Verify the password is not stored in state
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "password"),
 					// can't update configuration_permissions for now
 					resource.TestCheckResourceAttr("defectdojo_user.test", "configuration_permissions.#", "0"),
 				),
@@ -69,3 +79,96 @@ func TestAccUserResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccUserResourceContactInfo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+			resource "defectdojo_user" "test" {
+				username = "ContactUser"
+				email    = "contact@email.com"
+				contact_info = {
+					phone_number = "not a number"
+				}
+			}
+			`,
+				ExpectError: regexp.MustCompile("must be in the format"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "defectdojo_user" "test" {
+				username = "ContactUser"
+				email    = "contact@email.com"
+				contact_info = {
+					title         = "Security Engineer"
+					phone_number  = "+4930123456"
+					slack_user_id = "U012AB3CD"
+				}
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify set fields
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.title", "Security Engineer"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.phone_number", "+4930123456"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.slack_user_id", "U012AB3CD"),
+					// Verify default fields
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "contact_info.cell_number"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.block_execution", "false"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.force_password_reset", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "defectdojo_user" "test" {
+				username = "ContactUser"
+				email    = "contact@email.com"
+				contact_info = {
+					title           = "Security Engineer"
+					slack_username  = "contact.user"
+					slack_user_id   = "U012AB3CD"
+					github_username = "contact-user"
+					block_execution = true
+				}
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify updated fields
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "contact_info.phone_number"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.slack_username", "contact.user"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.github_username", "contact-user"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.block_execution", "true"),
+				),
+			},
+			// ImportState testing of the contact info
+			{
+				ResourceName: "defectdojo_user.test",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if github := states[0].Attributes["contact_info.github_username"]; github != "contact-user" {
+						return fmt.Errorf("expected the imported GitHub username to be contact-user, got %q", github)
+					}
+
+					return nil
+				},
+			},
+			// Removing the contact info stops managing it
+			{
+				Config: providerConfig + `
+			resource "defectdojo_user" "test" {
+				username = "ContactUser"
+				email    = "contact@email.com"
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "contact_info.title"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -19,13 +19,11 @@ func TestAccUsersDataSource(t *testing.T) {
 				resource "defectdojo_user" "test1" {
 					username = "User1"
 					email	 = "email1@email.com"
-					password = "veryHardPassword1234!"
 				}
 
 				resource "defectdojo_user" "test2" {
 					username = "User2"
 					email	 = "email2@email.com"
-					password = "veryHardPassword1234!"
 				}
                 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
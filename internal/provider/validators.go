@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ validator.String = hostValidator{}
+	_ validator.String = proxyValidator{}
+	_ validator.String = durationValidator{}
+	_ validator.String = endpointURLValidator{}
+)
+
+// parseHost parses the URL of a Defectdojo instance.
+// url.Parse accepts hosts without scheme, so we have to check the parts ourselves.
+// The path is kept as prefix for Defectdojo instances served behind a reverse proxy,
+// a trailing slash is removed so API paths can be appended.
+func parseHost(host string) (*url.URL, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("the host %q must start with http:// or https://", host)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("the host %q is missing a host name", host)
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("the host %q must not contain a query or fragment", host)
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+
+	return u, nil
+}
+
+// parseProxy parses the URL of an HTTP proxy.
+func parseProxy(proxy string) (*url.URL, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.New("the proxy must be a URL with scheme and host, e.g. http://proxy.example.com:3128")
+	}
+
+	return u, nil
+}
+
+// hostValidator validates the URL of a Defectdojo instance.
+type hostValidator struct{}
+
+func (v hostValidator) Description(_ context.Context) string {
+	return "value must be an http or https URL without query or fragment"
+}
+
+func (v hostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseHost(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Defectdojo API Host", err.Error())
+	}
+}
+
+// proxyValidator validates the URL of an HTTP proxy.
+type proxyValidator struct{}
+
+func (v proxyValidator) Description(_ context.Context) string {
+	return "value must be a URL with scheme and host"
+}
+
+func (v proxyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v proxyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseProxy(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid HTTP Proxy", err.Error())
+	}
+}
+
+// durationValidator validates that a string can be parsed with time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a duration like 30m or 12h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", err.Error())
+	}
+}
+
+// dateValidator validates that a string is a date in the format YYYY-MM-DD.
+type dateValidator struct{}
+
+func (v dateValidator) Description(_ context.Context) string {
+	return "value must be a date like 2024-12-31"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.DateOnly, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Date", err.Error())
+	}
+}
+
+// endpointURLValidator validates that a string can be split into the components of an endpoint.
+type endpointURLValidator struct{}
+
+func (v endpointURLValidator) Description(_ context.Context) string {
+	return "value must be a URL with at least a host, e.g. https://example.com/path"
+}
+
+func (v endpointURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v endpointURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseEndpointURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Endpoint URL", err.Error())
+	}
+}
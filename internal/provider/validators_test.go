@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitParseHost(t *testing.T) {
+	u, err := parseHost("https://defectdojo.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "https", u.Scheme)
+	require.Equal(t, "defectdojo.example.com", u.Host)
+
+	u, err = parseHost("http://localhost:8080/")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080", u.String())
+
+	u, err = parseHost("https://tools.example.com/defectdojo/")
+	require.NoError(t, err)
+	require.Equal(t, "https://tools.example.com/defectdojo", u.String())
+
+	_, err = parseHost("defectdojo.example.com")
+	require.ErrorContains(t, err, "http:// or https://")
+
+	_, err = parseHost("localhost:8080")
+	require.ErrorContains(t, err, "http:// or https://")
+
+	_, err = parseHost("https://")
+	require.ErrorContains(t, err, "missing a host name")
+
+	_, err = parseHost("https://example.com/defectdojo?a=b")
+	require.ErrorContains(t, err, "must not contain a query or fragment")
+}
+
+func TestUnitParseProxy(t *testing.T) {
+	_, err := parseProxy("http://proxy.example.com:3128")
+	require.NoError(t, err)
+
+	_, err = parseProxy("proxy.example.com")
+	require.Error(t, err)
+
+	_, err = parseProxy("http://%zz")
+	require.Error(t, err)
+}
+
+func TestUnitStringValidators(t *testing.T) {
+	tests := []struct {
+		validator validator.String
+		value     types.String
+		valid     bool
+	}{
+		{hostValidator{}, types.StringValue("https://defectdojo.example.com"), true},
+		{hostValidator{}, types.StringValue("https://tools.example.com/defectdojo"), true},
+		{hostValidator{}, types.StringValue("defectdojo.example.com"), false},
+		{hostValidator{}, types.StringNull(), true},
+		{hostValidator{}, types.StringUnknown(), true},
+		{proxyValidator{}, types.StringValue("http://proxy.example.com:3128"), true},
+		{proxyValidator{}, types.StringValue("proxy"), false},
+		{durationValidator{}, types.StringValue("12h"), true},
+		{durationValidator{}, types.StringValue("a day"), false},
+		{dateValidator{}, types.StringValue("2024-12-31"), true},
+		{dateValidator{}, types.StringValue("2024-13-01"), false},
+		{dateValidator{}, types.StringValue("31.12.2024"), false},
+		{endpointURLValidator{}, types.StringValue("https://a.example.com:443/"), true},
+		{endpointURLValidator{}, types.StringValue("https://"), false},
+	}
+
+	for _, test := range tests {
+		req := validator.StringRequest{
+			Path:        path.Root("test"),
+			ConfigValue: test.value,
+		}
+		resp := &validator.StringResponse{}
+
+		test.validator.ValidateString(context.Background(), req, resp)
+		require.Equal(t, !test.valid, resp.Diagnostics.HasError(), "%T with %s", test.validator, test.value)
+	}
+}